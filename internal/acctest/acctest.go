@@ -49,6 +49,7 @@ import (
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/jmespath/go-jmespath"
 	"github.com/mitchellh/mapstructure"
 )
@@ -825,6 +826,11 @@ func PreCheckPartitionHasService(t *testing.T, serviceID string) {
 			t.Skipf("skipping tests; partition %s does not support %s service", partition.ID(), serviceID)
 		}
 	}
+
+	// The AWS SDK doesn't know about all partitions or all services unavailable in them.
+	if partition := names.PartitionForRegion(Region()); !partition.IsServiceAvailable(serviceID) {
+		t.Skipf("skipping tests; partition %s does not support %s service", partition.ID, serviceID)
+	}
 }
 
 func PreCheckMultipleRegion(t *testing.T, regions int) {
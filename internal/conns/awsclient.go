@@ -37,6 +37,7 @@ type AWSClient struct {
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
+	route53RecordSetCache     Route53RecordSetCache
 	s3UsePathStyle            bool                                      // From provider configuration.
 	s3UsEast1RegionalEndpoint endpoints_sdkv1.S3UsEast1RegionalEndpoint // From provider configuration.
 	stsRegion                 string                                    // From provider configuration.
@@ -61,6 +62,11 @@ func (client *AWSClient) S3UsePathStyle() bool {
 	return client.s3UsePathStyle
 }
 
+// Route53RecordSetCache returns the cache of Route 53 hosted zone record set listings.
+func (client *AWSClient) Route53RecordSetCache() *Route53RecordSetCache {
+	return &client.route53RecordSetCache
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (client *AWSClient) SetHTTPClient(httpClient *http.Client) {
@@ -98,8 +104,8 @@ func (client *AWSClient) APIGatewayV2InvokeURL(protocolType, apiID, stageName st
 // CloudFrontDistributionHostedZoneID returns the Route 53 hosted zone ID
 // for Amazon CloudFront distributions in the configured AWS partition.
 func (client *AWSClient) CloudFrontDistributionHostedZoneID() string {
-	if client.Partition == endpoints_sdkv1.AwsCnPartitionID {
-		return "Z3RFFRIM2A3IF5" // See https://docs.amazonaws.cn/en_us/aws/latest/userguide/route53.html
+	if v, ok := client.PartitionData().HostedZoneID(names.CloudFront); ok {
+		return v
 	}
 	return "Z2FDTNDATAQYW2" // See https://docs.aws.amazon.com/Route53/latest/APIReference/API_AliasTarget.html#Route53-Type-AliasTarget-HostedZoneId
 }
@@ -128,9 +134,32 @@ func (client *AWSClient) DefaultKMSKeyPolicy() string {
 // GlobalAcceleratorHostedZoneID returns the Route 53 hosted zone ID
 // for AWS Global Accelerator accelerators in the configured AWS partition.
 func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
+	if v, ok := client.PartitionData().HostedZoneID(names.GlobalAccelerator); ok {
+		return v
+	}
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
 
+// PartitionData returns the provider's metadata for the configured AWS partition.
+func (client *AWSClient) PartitionData() *names.PartitionDatum {
+	if v, err := names.PartitionForID(client.Partition); err == nil {
+		return v
+	}
+
+	return names.PartitionForRegion(client.Region)
+}
+
+// ServicePrincipal returns the IAM service principal for the specified service
+// (e.g. "ec2" or "logs") in the configured AWS partition.
+func (client *AWSClient) ServicePrincipal(service string) string {
+	return client.PartitionData().ServicePrincipal(service)
+}
+
+// IsServiceAvailable returns whether the specified service package is available in the configured AWS partition.
+func (client *AWSClient) IsServiceAvailable(service string) bool {
+	return client.PartitionData().IsServiceAvailable(service)
+}
+
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
 	m := map[string]any{
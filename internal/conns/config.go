@@ -174,8 +174,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, sdkdiag.AppendErrorf(diags, err.Error())
 	}
 
-	DNSSuffix := "amazonaws.com"
-	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
+	// The AWS SDKs don't know about all partitions, so fall back to the provider's own partition data.
+	partitionData := names.PartitionForRegion(c.Region)
+	if partition == "" {
+		partition = partitionData.ID
+	}
+
+	DNSSuffix := partitionData.DNSSuffix
+	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok && p.ID() == partition {
 		DNSSuffix = p.DNSSuffix()
 	}
 
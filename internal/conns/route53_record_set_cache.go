@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	route53_sdkv1 "github.com/aws/aws-sdk-go/service/route53"
+)
+
+const (
+	// route53RecordSetCacheTTL is how long a hosted zone's record sets are cached, and how long reads of
+	// the hosted zone's record sets are counted, roughly the refresh of a single Terraform operation.
+	// Changes made outside this provider are seen once a listing expires.
+	route53RecordSetCacheTTL = 1 * time.Minute
+	// route53RecordSetCacheMinReads is the number of record sets in a hosted zone that must be read within
+	// route53RecordSetCacheTTL before the hosted zone's record sets are listed instead of looked up individually.
+	route53RecordSetCacheMinReads = 5
+)
+
+// Route53HostedZoneRecordSets is the complete listing of a Route 53 hosted zone's record sets.
+type Route53HostedZoneRecordSets struct {
+	HostedZone *route53_sdkv1.HostedZone
+	RecordSets []*route53_sdkv1.ResourceRecordSet
+}
+
+// Route53RecordSetCache caches the record sets of Route 53 hosted zones, keyed by hosted zone ID.
+// It lets many aws_route53_record resources in the same zone be refreshed from a single listing.
+// The zero value is ready to use.
+type Route53RecordSetCache struct {
+	lock  sync.Mutex
+	now   func() time.Time
+	reads map[string]*route53RecordSetCacheReads
+	zones map[string]*route53RecordSetCacheEntry
+}
+
+type route53RecordSetCacheEntry struct {
+	done    chan struct{}
+	err     error
+	expires time.Time
+	value   *Route53HostedZoneRecordSets
+}
+
+type route53RecordSetCacheReads struct {
+	count   int
+	expires time.Time
+}
+
+// UseListing records a read of a record set in the specified hosted zone and returns whether
+// the read should use the hosted zone's listing from Get rather than look up the record set individually.
+// The listing is used once several record sets in the hosted zone are read within a short time,
+// so that managing a few records in a large hosted zone doesn't list the whole zone on every refresh.
+func (c *Route53RecordSetCache) UseListing(zoneID string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.timeNow()
+
+	if c.reads == nil {
+		c.reads = make(map[string]*route53RecordSetCacheReads)
+	}
+	reads, ok := c.reads[zoneID]
+	if !ok || now.After(reads.expires) {
+		reads = &route53RecordSetCacheReads{
+			expires: now.Add(route53RecordSetCacheTTL),
+		}
+		c.reads[zoneID] = reads
+	}
+	reads.count++
+
+	if entry, ok := c.zones[zoneID]; ok && !entry.expired(now) {
+		return true
+	}
+
+	return reads.count >= route53RecordSetCacheMinReads
+}
+
+// Get returns the record sets of the specified hosted zone, calling list to populate the cache if necessary.
+// Concurrent callers for the same hosted zone share a single call to list.
+// Errors are not cached.
+func (c *Route53RecordSetCache) Get(ctx context.Context, zoneID string, list func(context.Context) (*Route53HostedZoneRecordSets, error)) (*Route53HostedZoneRecordSets, error) {
+	c.lock.Lock()
+	if c.zones == nil {
+		c.zones = make(map[string]*route53RecordSetCacheEntry)
+	}
+	entry, ok := c.zones[zoneID]
+	if ok && entry.expired(c.timeNow()) {
+		ok = false
+	}
+	if !ok {
+		entry = &route53RecordSetCacheEntry{
+			done: make(chan struct{}),
+		}
+		c.zones[zoneID] = entry
+	}
+	c.lock.Unlock()
+
+	if !ok {
+		entry.value, entry.err = list(ctx)
+
+		if entry.err != nil {
+			c.remove(zoneID, entry)
+		}
+
+		c.lock.Lock()
+		entry.expires = c.timeNow().Add(route53RecordSetCacheTTL)
+		c.lock.Unlock()
+
+		close(entry.done)
+	}
+
+	select {
+	case <-entry.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return entry.value, entry.err
+}
+
+// Invalidate discards the cached record sets of the specified hosted zone.
+// It must be called after any change to the hosted zone's record sets.
+func (c *Route53RecordSetCache) Invalidate(zoneID string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.zones, zoneID)
+}
+
+func (c *Route53RecordSetCache) remove(zoneID string, entry *route53RecordSetCacheEntry) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.zones[zoneID] == entry {
+		delete(c.zones, zoneID)
+	}
+}
+
+func (c *Route53RecordSetCache) timeNow() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+
+	return time.Now()
+}
+
+// expired returns whether a completed listing is older than route53RecordSetCacheTTL.
+// A listing in progress, with a zero expiry time, has not expired.
+func (e *route53RecordSetCacheEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && now.After(e.expires)
+}
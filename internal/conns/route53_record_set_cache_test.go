@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRoute53RecordSetCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var cache Route53RecordSetCache
+	var calls int32
+	list := func(context.Context) (*Route53HostedZoneRecordSets, error) {
+		atomic.AddInt32(&calls, 1)
+		return &Route53HostedZoneRecordSets{}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.Get(ctx, "Z1", list); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := atomic.LoadInt32(&calls), int32(1); got != want {
+		t.Errorf("got %d listings, expected %d", got, want)
+	}
+
+	if _, err := cache.Get(ctx, "Z2", list); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("got %d listings, expected %d", got, want)
+	}
+
+	cache.Invalidate("Z1")
+
+	if _, err := cache.Get(ctx, "Z1", list); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := atomic.LoadInt32(&calls), int32(3); got != want {
+		t.Errorf("got %d listings after invalidation, expected %d", got, want)
+	}
+}
+
+func TestRoute53RecordSetCache_error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var cache Route53RecordSetCache
+	var calls int32
+	list := func(context.Context) (*Route53HostedZoneRecordSets, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return nil, errors.New("throttled")
+		}
+		return &Route53HostedZoneRecordSets{}, nil
+	}
+
+	if _, err := cache.Get(ctx, "Z1", list); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if _, err := cache.Get(ctx, "Z1", list); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("got %d listings, expected %d", got, want)
+	}
+}
+
+func TestRoute53RecordSetCache_expiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	cache := Route53RecordSetCache{
+		now: func() time.Time { return now },
+	}
+	var calls int32
+	list := func(context.Context) (*Route53HostedZoneRecordSets, error) {
+		atomic.AddInt32(&calls, 1)
+		return &Route53HostedZoneRecordSets{}, nil
+	}
+
+	if _, err := cache.Get(ctx, "Z1", list); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	now = now.Add(route53RecordSetCacheTTL / 2)
+
+	if _, err := cache.Get(ctx, "Z1", list); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := atomic.LoadInt32(&calls), int32(1); got != want {
+		t.Errorf("got %d listings, expected %d", got, want)
+	}
+
+	now = now.Add(route53RecordSetCacheTTL)
+
+	if _, err := cache.Get(ctx, "Z1", list); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("got %d listings after expiry, expected %d", got, want)
+	}
+}
+
+func TestRoute53RecordSetCache_useListing(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	cache := Route53RecordSetCache{
+		now: func() time.Time { return now },
+	}
+
+	// A few reads in a hosted zone look up record sets individually.
+	for i := 1; i < route53RecordSetCacheMinReads; i++ {
+		if cache.UseListing("Z1") {
+			t.Fatalf("read %d: expected individual lookup", i)
+		}
+	}
+
+	if !cache.UseListing("Z1") {
+		t.Fatal("expected listing")
+	}
+
+	if cache.UseListing("Z2") {
+		t.Fatal("Z2: expected individual lookup")
+	}
+
+	// Reads are counted only within the TTL.
+	now = now.Add(2 * route53RecordSetCacheTTL)
+
+	if cache.UseListing("Z1") {
+		t.Fatal("expected individual lookup after expiry")
+	}
+
+	// A hosted zone with a cached listing always uses it.
+	if _, err := cache.Get(ctx, "Z2", func(context.Context) (*Route53HostedZoneRecordSets, error) {
+		return &Route53HostedZoneRecordSets{}, nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !cache.UseListing("Z2") {
+		t.Fatal("Z2: expected listing")
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	pluralDataSourceAttrARNs      = "arns"
+	pluralDataSourceAttrNameRegex = "name_regex"
+)
+
+// PluralDataSourceSpec describes a data source that lists all resources of a type,
+// optionally filtered by name and tags.
+type PluralDataSourceSpec[T any] struct {
+	// TypeName is the data source's Terraform type name, e.g. "aws_sns_topics".
+	TypeName string
+
+	// IDsAttribute is the name of the computed list attribute holding the value returned by ID,
+	// e.g. "names" or "ids".
+	IDsAttribute string
+
+	// Attributes are any additional configuration attributes the data source accepts.
+	// They are available to List via the data source's configuration.
+	Attributes map[string]schema.Attribute
+
+	// List calls fn for each resource, typically using an AWS SDK paginator, stopping early if fn returns false.
+	List func(ctx context.Context, meta *conns.AWSClient, config tfsdk.Config, fn func(T) bool) error
+
+	// ARN returns the resource's ARN.
+	ARN func(meta *conns.AWSClient, v T) string
+
+	// ID returns the resource's name or ID, the value matched against `name_regex`.
+	ID func(v T) string
+
+	// TaggingResourceType is the Resource Groups Tagging API resource type filter, e.g. "sns" or "kms:key".
+	// If set, the data source accepts a `tags` argument and only resources whose ARNs
+	// the Resource Groups Tagging API returns for the configured tags are included.
+	// The Tagging API is eventually consistent, so recently created or tagged resources may be missing.
+	TaggingResourceType string
+}
+
+// PluralDataSource implements a data source returning the ARNs and names (or IDs) of all
+// resources of a type in the configured Region.
+type PluralDataSource[T any] struct {
+	DataSourceWithConfigure
+
+	spec PluralDataSourceSpec[T]
+}
+
+// NewPluralDataSource returns a new plural data source for the specified resources.
+func NewPluralDataSource[T any](spec PluralDataSourceSpec[T]) *PluralDataSource[T] {
+	return &PluralDataSource[T]{
+		spec: spec,
+	}
+}
+
+func (d *PluralDataSource[T]) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = d.spec.TypeName
+}
+
+func (d *PluralDataSource[T]) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		pluralDataSourceAttrARNs: schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		names.AttrID: schema.StringAttribute{
+			Computed: true,
+		},
+		d.spec.IDsAttribute: schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		pluralDataSourceAttrNameRegex: schema.StringAttribute{
+			CustomType: fwtypes.RegexpType,
+			Optional:   true,
+		},
+	}
+
+	if d.spec.TaggingResourceType != "" {
+		attributes[names.AttrTags] = tftags.TagsAttribute()
+	}
+
+	for k, v := range d.spec.Attributes {
+		attributes[k] = v
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (d *PluralDataSource[T]) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var nameRegex fwtypes.Regexp
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(pluralDataSourceAttrNameRegex), &nameRegex)...)
+
+	var tags types.Map
+	if d.spec.TaggingResourceType != "" {
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrTags), &tags)...)
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	meta := d.Meta()
+
+	var taggedARNs map[string]struct{}
+	if filterTags := tftags.New(ctx, tags); len(filterTags) > 0 {
+		var err error
+		taggedARNs, err = findTaggedResourceARNs(ctx, meta.ResourceGroupsTaggingAPIConn(ctx), d.spec.TaggingResourceType, filterTags)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading %s tagged resources", d.spec.TypeName), err.Error())
+
+			return
+		}
+	}
+
+	var arns, ids []string
+	err := d.spec.List(ctx, meta, request.Config, func(v T) bool {
+		arn, id := d.spec.ARN(meta, v), d.spec.ID(v)
+
+		if taggedARNs != nil {
+			if _, ok := taggedARNs[arn]; !ok {
+				return true
+			}
+		}
+
+		if !nameRegex.IsNull() && !nameRegex.ValueRegexp().MatchString(id) {
+			return true
+		}
+
+		arns = append(arns, arn)
+		ids = append(ids, id)
+
+		return true
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading %s", d.spec.TypeName), err.Error())
+
+		return
+	}
+
+	// Copy the configuration, including any data source-specific arguments, into state.
+	response.State.Raw = request.Config.Raw
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), meta.Region)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(pluralDataSourceAttrARNs), flex.FlattenFrameworkStringValueListLegacy(ctx, arns))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(d.spec.IDsAttribute), flex.FlattenFrameworkStringValueListLegacy(ctx, ids))...)
+}
+
+// findTaggedResourceARNs returns the ARNs of all resources of the specified type having all the specified tags.
+func findTaggedResourceARNs(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceType string, tags tftags.KeyValueTags) (map[string]struct{}, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{resourceType}),
+	}
+
+	for k, v := range tags.Map() {
+		input.TagFilters = append(input.TagFilters, &resourcegroupstaggingapi.TagFilter{
+			Key:    aws.String(k),
+			Values: aws.StringSlice([]string{v}),
+		})
+	}
+
+	arns := make(map[string]struct{})
+	err := conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			arns[aws.StringValue(v.ResourceARN)] = struct{}{}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return arns, nil
+}
@@ -94,7 +94,7 @@ func sweepComputeEnvironments(region string) error {
 					continue
 				}
 
-				servicePrincipal := fmt.Sprintf("%s.%s", batch.EndpointsID, sweep.PartitionDNSSuffix(region))
+				servicePrincipal := client.ServicePrincipal(batch.EndpointsID)
 				serviceRoleName := strings.TrimPrefix(serviceRoleARN.Resource, "role/")
 				serviceRolePolicyARN := arn.ARN{
 					AccountID: "aws",
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// WaitDeploymentSucceededOrReady waits for a deployment to succeed, or for a blue/green deployment
+// whose deployment group reroutes traffic manually to be ready for traffic to be rerouted.
+// If the deployment fails or is stopped, the returned error includes the reason and any rollback.
+func WaitDeploymentSucceededOrReady(ctx context.Context, conn *codedeploy.CodeDeploy, id string, timeout time.Duration) (*codedeploy.DeploymentInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			codedeploy.DeploymentStatusBaking,
+			codedeploy.DeploymentStatusCreated,
+			codedeploy.DeploymentStatusInProgress,
+			codedeploy.DeploymentStatusQueued,
+		},
+		Target:     []string{codedeploy.DeploymentStatusReady, codedeploy.DeploymentStatusSucceeded},
+		Refresh:    statusDeployment(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*codedeploy.DeploymentInfo); ok {
+		tfresource.SetLastError(err, deploymentError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func deploymentError(apiObject *codedeploy.DeploymentInfo) error {
+	var errs []error
+
+	if v := apiObject.ErrorInformation; v != nil {
+		errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+	}
+
+	if v := apiObject.RollbackInfo; v != nil && v.RollbackDeploymentId != nil {
+		errs = append(errs, fmt.Errorf("rolled back by deployment %s: %s", aws.StringValue(v.RollbackDeploymentId), aws.StringValue(v.RollbackMessage)))
+	}
+
+	return errors.Join(errs...)
+}
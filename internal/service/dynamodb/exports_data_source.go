@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_dynamodb_exports", name="Exports")
+func DataSourceExports() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceExportsRead,
+
+		Schema: map[string]*schema.Schema{
+			"exports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"export_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"export_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceExportsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	input := &dynamodb.ListExportsInput{}
+
+	if v, ok := d.GetOk("table_arn"); ok {
+		input.TableArn = aws.String(v.(string))
+	}
+
+	var exports []*dynamodb.ExportSummary
+
+	err := conn.ListExportsPagesWithContext(ctx, input, func(page *dynamodb.ListExportsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ExportSummaries {
+			if v != nil {
+				exports = append(exports, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing DynamoDB Exports: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("exports", flattenExportSummaries(exports)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting exports: %s", err)
+	}
+
+	return diags
+}
+
+func flattenExportSummaries(apiObjects []*dynamodb.ExportSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"export_arn":    aws.StringValue(apiObject.ExportArn),
+			"export_status": aws.StringValue(apiObject.ExportStatus),
+		})
+	}
+
+	return tfList
+}
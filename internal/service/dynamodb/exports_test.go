@@ -5,5 +5,6 @@ package dynamodb
 
 // Exports for use in tests only.
 var (
-	ListTags = listTags
+	FindExportByARN = findExportByARN
+	ListTags        = listTags
 )
@@ -307,3 +307,32 @@ func TestFlattenTableItemAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandInputFormatOptions(t *testing.T) {
+	t.Parallel()
+
+	// CSV header order maps column positions to attribute names.
+	tfMap := map[string]interface{}{
+		"csv": []interface{}{
+			map[string]interface{}{
+				"delimiter":   ";",
+				"header_list": []interface{}{"id", "zulu", "alpha", "mike"},
+			},
+		},
+	}
+
+	got := expandInputFormatOptions(tfMap)
+	want := []string{"id", "zulu", "alpha", "mike"}
+
+	if got.Csv == nil {
+		t.Fatal("expected CSV options")
+	}
+
+	if got, want := aws.StringValue(got.Csv.Delimiter), ";"; got != want {
+		t.Errorf("got delimiter %q, expected %q", got, want)
+	}
+
+	if got := aws.StringValueSlice(got.Csv.HeaderList); !slices.Equal(got, want) {
+		t.Errorf("got header list %v, expected %v", got, want)
+	}
+}
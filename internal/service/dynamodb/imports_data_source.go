@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_dynamodb_imports", name="Imports")
+func DataSourceImports() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceImportsRead,
+
+		Schema: map[string]*schema.Schema{
+			"imports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_log_group_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"input_format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"bucket_owner": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"key_prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"table_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceImportsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	input := &dynamodb.ListImportsInput{}
+
+	if v, ok := d.GetOk("table_arn"); ok {
+		input.TableArn = aws.String(v.(string))
+	}
+
+	var imports []*dynamodb.ImportSummary
+
+	err := conn.ListImportsPagesWithContext(ctx, input, func(page *dynamodb.ListImportsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ImportSummaryList {
+			if v != nil {
+				imports = append(imports, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing DynamoDB Imports: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("imports", flattenImportSummaries(imports)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting imports: %s", err)
+	}
+
+	return diags
+}
+
+func flattenImportSummaries(apiObjects []*dynamodb.ImportSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"cloudwatch_log_group_arn": aws.StringValue(apiObject.CloudWatchLogGroupArn),
+			"import_arn":               aws.StringValue(apiObject.ImportArn),
+			"import_status":            aws.StringValue(apiObject.ImportStatus),
+			"input_format":             aws.StringValue(apiObject.InputFormat),
+			"table_arn":                aws.StringValue(apiObject.TableArn),
+		}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.S3BucketSource; v != nil {
+			tfMap["s3_bucket_source"] = []interface{}{map[string]interface{}{
+				"bucket":       aws.StringValue(v.S3Bucket),
+				"bucket_owner": aws.StringValue(v.S3BucketOwner),
+				"key_prefix":   aws.StringValue(v.S3KeyPrefix),
+			}}
+		}
+
+		if v := apiObject.StartTime; v != nil {
+			tfMap["start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDynamoDBImportsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table.test"
+	dataSourceName := "data.aws_dynamodb_imports.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "imports.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "imports.0.import_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "imports.0.import_status", dynamodb.ImportStatusCompleted),
+					resource.TestCheckResourceAttr(dataSourceName, "imports.0.input_format", dynamodb.InputFormatCsv),
+					resource.TestCheckResourceAttr(dataSourceName, "imports.0.s3_bucket_source.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "imports.0.s3_bucket_source.0.bucket", rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "imports.0.table_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImportsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/items.csv"
+  content = <<EOF
+id,value
+1,one
+EOF
+}
+
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  import_table {
+    input_format = "CSV"
+
+    s3_bucket_source {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "data/"
+    }
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+
+data "aws_dynamodb_imports" "test" {
+  table_arn = aws_dynamodb_table.test.arn
+}
+`, rName)
+}
@@ -49,6 +49,38 @@ func statusTable(ctx context.Context, conn *dynamodb.DynamoDB, tableName string)
 	}
 }
 
+func statusExport(ctx context.Context, conn *dynamodb.DynamoDB, exportARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findExportByARN(ctx, conn, exportARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ExportStatus), nil
+	}
+}
+
+func statusImport(ctx context.Context, conn *dynamodb.DynamoDB, importARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findImportByARN(ctx, conn, importARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ImportStatus), nil
+	}
+}
+
 func statusReplicaUpdate(ctx context.Context, conn *dynamodb.DynamoDB, tableName, region string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		result, err := conn.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{
@@ -6,6 +6,7 @@ package dynamodb
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -104,6 +106,21 @@ func ResourceTable() *schema.Resource {
 				}
 				return errs.ErrorOrNil()
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if diff.Id() != "" {
+					return nil
+				}
+
+				if v, ok := diff.GetOk("import_table"); !ok || len(v.([]interface{})) == 0 {
+					return nil
+				}
+
+				if v, ok := diff.GetOk("local_secondary_index"); ok && v.(*schema.Set).Len() > 0 {
+					return errors.New("local_secondary_index cannot be specified with import_table")
+				}
+
+				return nil
+			},
 			customdiff.ForceNewIfChange("restore_source_name", func(_ context.Context, old, new, meta interface{}) bool {
 				// If they differ force new unless new is cleared
 				// https://github.com/hashicorp/terraform-provider-aws/issues/25214
@@ -198,6 +215,86 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"import_table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"restore_source_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_compression_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+						},
+						"input_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+						},
+						"input_format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"header_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -486,6 +583,78 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		if err != nil {
 			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
 		}
+	} else if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		billingMode := d.Get("billing_mode").(string)
+		tcp := &dynamodb.TableCreationParameters{
+			BillingMode: aws.String(billingMode),
+			KeySchema:   expandKeySchema(keySchemaMap),
+			TableName:   aws.String(tableName),
+		}
+
+		capacityMap := map[string]interface{}{
+			"write_capacity": d.Get("write_capacity"),
+			"read_capacity":  d.Get("read_capacity"),
+		}
+
+		tcp.ProvisionedThroughput = expandProvisionedThroughput(capacityMap, billingMode)
+
+		if v, ok := d.GetOk("attribute"); ok {
+			aSet := v.(*schema.Set)
+			tcp.AttributeDefinitions = expandAttributes(aSet.List())
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok {
+			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
+			gsiSet := v.(*schema.Set)
+
+			for _, gsiObject := range gsiSet.List() {
+				gsi := gsiObject.(map[string]interface{})
+				if err := validateGSIProvisionedThroughput(gsi, billingMode); err != nil {
+					return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+				}
+
+				gsiObject := expandGlobalSecondaryIndex(gsi, billingMode)
+				globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
+			}
+			tcp.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			tcp.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		input := expandImportTable(v.([]interface{})[0].(map[string]interface{}))
+		input.TableCreationParameters = tcp
+
+		outputRaw, err := tfresource.RetryWhen(ctx, createTableTimeout, func() (interface{}, error) {
+			return conn.ImportTableWithContext(ctx, input)
+		}, func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, "ThrottlingException") {
+				return true, err
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+				return true, err
+			}
+
+			return false, err
+		})
+
+		if err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+		}
+
+		// The table exists as soon as the import starts.
+		d.SetId(tableName)
+
+		importARN := aws.StringValue(outputRaw.(*dynamodb.ImportTableOutput).ImportTableDescription.ImportArn)
+
+		if _, err := waitImportCompleted(ctx, conn, importARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Id(), fmt.Errorf("import (%s): %w", importARN, err))
+		}
+
+		if err := updateImportedTable(ctx, conn, d); err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), err)
+		}
 	} else {
 		input := &dynamodb.CreateTableInput{
 			BillingMode: aws.String(d.Get("billing_mode").(string)),
@@ -1043,6 +1212,67 @@ func resourceTableDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 // custom diff
 
+// updateImportedTable applies the table settings that ImportTable does not support.
+func updateImportedTable(ctx context.Context, conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
+	hasTableUpdate := false
+	input := &dynamodb.UpdateTableInput{
+		TableName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("deletion_protection_enabled"); ok {
+		hasTableUpdate = true
+		input.DeletionProtectionEnabled = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("stream_enabled"); ok {
+		hasTableUpdate = true
+		input.StreamSpecification = &dynamodb.StreamSpecification{
+			StreamEnabled:  aws.Bool(v.(bool)),
+			StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+		}
+	}
+
+	if hasTableUpdate {
+		if _, err := conn.UpdateTableWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating imported table: %w", err)
+		}
+
+		if _, err := waitTableActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("waiting for imported table update: %w", err)
+		}
+	}
+
+	// Table Class cannot be changed concurrently with other values
+	if v, ok := d.GetOk("table_class"); ok && v.(string) != dynamodb.TableClassStandard {
+		input := &dynamodb.UpdateTableInput{
+			TableClass: aws.String(v.(string)),
+			TableName:  aws.String(d.Id()),
+		}
+
+		if _, err := conn.UpdateTableWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating imported table class: %w", err)
+		}
+
+		if _, err := waitTableActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("waiting for imported table class update: %w", err)
+		}
+	}
+
+	if tags := getTagsIn(ctx); len(tags) > 0 {
+		table, err := FindTableByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("reading imported table: %w", err)
+		}
+
+		if err := updateTags(ctx, conn, aws.StringValue(table.TableArn), nil, KeyValueTags(ctx, tags)); err != nil {
+			return fmt.Errorf("setting imported table tags: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func isTableOptionDisabled(v interface{}) bool {
 	options := v.([]interface{})
 	if len(options) == 0 {
@@ -2114,6 +2344,69 @@ func expandEncryptAtRestOptions(vOptions []interface{}) *dynamodb.SSESpecificati
 	return options
 }
 
+func expandImportTable(tfMap map[string]interface{}) *dynamodb.ImportTableInput {
+	apiObject := &dynamodb.ImportTableInput{
+		ClientToken: aws.String(id.UniqueId()),
+	}
+
+	if v, ok := tfMap["input_compression_type"].(string); ok && v != "" {
+		apiObject.InputCompressionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_format"].(string); ok && v != "" {
+		apiObject.InputFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InputFormatOptions = expandInputFormatOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_bucket_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3BucketSource = expandS3BucketSource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandInputFormatOptions(tfMap map[string]interface{}) *dynamodb.InputFormatOptions {
+	apiObject := &dynamodb.InputFormatOptions{}
+
+	if v, ok := tfMap["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		csv := &dynamodb.CsvOptions{}
+
+		if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+			csv.Delimiter = aws.String(v)
+		}
+
+		if v, ok := tfMap["header_list"].([]interface{}); ok && len(v) > 0 {
+			csv.HeaderList = flex.ExpandStringList(v)
+		}
+
+		apiObject.Csv = csv
+	}
+
+	return apiObject
+}
+
+func expandS3BucketSource(tfMap map[string]interface{}) *dynamodb.S3BucketSource {
+	apiObject := &dynamodb.S3BucketSource{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+		apiObject.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
 // validators
 
 func validateTableAttributes(d *schema.ResourceDiff) error {
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource(name="Tables")
+func newDataSourceTables(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return framework.NewPluralDataSource(framework.PluralDataSourceSpec[string]{
+		TypeName:     "aws_dynamodb_tables",
+		IDsAttribute: "names",
+		List: func(ctx context.Context, meta *conns.AWSClient, _ tfsdk.Config, fn func(string) bool) error {
+			conn := meta.DynamoDBConn(ctx)
+
+			return conn.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{}, func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.TableNames {
+					if !fn(aws.StringValue(v)) {
+						return false
+					}
+				}
+
+				return !lastPage
+			})
+		},
+		ARN: func(meta *conns.AWSClient, v string) string {
+			return arn.ARN{
+				Partition: meta.Partition,
+				Service:   dynamodb.ServiceName,
+				Region:    meta.Region,
+				AccountID: meta.AccountID,
+				Resource:  "table/" + v,
+			}.String()
+		},
+		ID: func(v string) string {
+			return v
+		},
+		TaggingResourceType: "dynamodb:table",
+	}), nil
+}
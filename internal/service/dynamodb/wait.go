@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -77,6 +79,49 @@ func waitTableActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName str
 	return nil, err
 }
 
+func waitExportCompleted(ctx context.Context, conn *dynamodb.DynamoDB, exportARN string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+		Refresh: statusExport(ctx, conn, exportARN),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if code, message := aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage); code != "" || message != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", code, message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitImportCompleted(ctx context.Context, conn *dynamodb.DynamoDB, importARN string, timeout time.Duration) (*dynamodb.ImportTableDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dynamodb.ImportStatusInProgress},
+		Target:  []string{dynamodb.ImportStatusCompleted},
+		Timeout: maxDuration(createTableTimeout, timeout),
+		Refresh: statusImport(ctx, conn, importARN),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ImportTableDescription); ok {
+		if code, message := aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage); code != "" || message != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", code, message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitTableDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, timeout time.Duration) (*dynamodb.TableDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{dynamodb.TableStatusActive, dynamodb.TableStatusDeleting},
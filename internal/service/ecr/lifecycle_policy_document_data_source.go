@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	lifecyclePolicyActionTypeExpire = "expire"
+
+	lifecyclePolicyCountTypeImageCountMoreThan = "imageCountMoreThan"
+	lifecyclePolicyCountTypeSinceImagePushed   = "sinceImagePushed"
+
+	lifecyclePolicyCountUnitDays = "days"
+
+	lifecyclePolicyTagStatusAny      = "any"
+	lifecyclePolicyTagStatusTagged   = "tagged"
+	lifecyclePolicyTagStatusUntagged = "untagged"
+)
+
+// @SDKDataSource("aws_ecr_lifecycle_policy_document")
+func DataSourceLifecyclePolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLifecyclePolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyActionTypeExpire}, false),
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"count_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyCountTypeImageCountMoreThan, lifecyclePolicyCountTypeSinceImagePushed}, false),
+									},
+									"count_unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyCountUnitDays}, false),
+									},
+									"tag_pattern_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"tag_prefix_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"tag_status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyTagStatusAny, lifecyclePolicyTagStatusTagged, lifecyclePolicyTagStatusUntagged}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLifecyclePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy := &lifecyclePolicy{
+		Rules: expandLifecyclePolicyRules(d.Get("rule").([]interface{})),
+	}
+
+	if err := policy.validate(); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing ECR Lifecycle Policy Document: %s", err)
+	}
+
+	policy.reduce()
+
+	b, err := jsonutil.BuildJSON(policy)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing ECR Lifecycle Policy Document: %s", err)
+	}
+
+	var jsonDoc bytes.Buffer
+
+	if err := json.Indent(&jsonDoc, b, "", "  "); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing ECR Lifecycle Policy Document: %s", err)
+	}
+
+	jsonString := jsonDoc.String()
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+// validate checks the lifecycle policy rules against the constraints that ECR applies when the policy is put.
+// See https://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html#lifecycle_policy_parameters.
+func (lp *lifecyclePolicy) validate() error {
+	var errs []error
+	priorities := make(map[int64]struct{})
+	var anyPriority, maxPriority int64
+
+	for _, rule := range lp.Rules {
+		priority := aws.Int64Value(rule.RulePriority)
+
+		if _, ok := priorities[priority]; ok {
+			errs = append(errs, fmt.Errorf("rule priority %d is not unique", priority))
+		}
+		priorities[priority] = struct{}{}
+
+		if priority > maxPriority {
+			maxPriority = priority
+		}
+
+		for _, err := range rule.Selection.validate() {
+			errs = append(errs, fmt.Errorf("rule %d: %w", priority, err))
+		}
+
+		if aws.StringValue(rule.Selection.TagStatus) == lifecyclePolicyTagStatusAny {
+			if anyPriority != 0 {
+				errs = append(errs, fmt.Errorf("rule %d: only one rule can have tag_status %q", priority, lifecyclePolicyTagStatusAny))
+			}
+			anyPriority = priority
+		}
+	}
+
+	if anyPriority != 0 && anyPriority != maxPriority {
+		errs = append(errs, fmt.Errorf("rule %d: a rule with tag_status %q must have the highest priority", anyPriority, lifecyclePolicyTagStatusAny))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (lprs *lifecyclePolicyRuleSelection) validate() []error {
+	var errs []error
+
+	switch tagStatus := aws.StringValue(lprs.TagStatus); tagStatus {
+	case lifecyclePolicyTagStatusTagged:
+		if (len(lprs.TagPatternList) == 0) == (len(lprs.TagPrefixList) == 0) {
+			errs = append(errs, fmt.Errorf("exactly one of tag_pattern_list or tag_prefix_list must be set when tag_status is %q", tagStatus))
+		}
+	default:
+		if len(lprs.TagPatternList) > 0 || len(lprs.TagPrefixList) > 0 {
+			errs = append(errs, fmt.Errorf("tag_pattern_list and tag_prefix_list can only be set when tag_status is %q", lifecyclePolicyTagStatusTagged))
+		}
+	}
+
+	switch countType := aws.StringValue(lprs.CountType); countType {
+	case lifecyclePolicyCountTypeImageCountMoreThan:
+		if lprs.CountUnit != nil {
+			errs = append(errs, fmt.Errorf("count_unit cannot be set when count_type is %q", countType))
+		}
+	case lifecyclePolicyCountTypeSinceImagePushed:
+		if lprs.CountUnit == nil {
+			errs = append(errs, fmt.Errorf("count_unit must be set when count_type is %q", countType))
+		}
+	}
+
+	return errs
+}
+
+func expandLifecyclePolicyRules(tfList []interface{}) []*lifecyclePolicyRule {
+	var apiObjects []*lifecyclePolicyRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lifecyclePolicyRule{
+			Action: &lifecyclePolicyRuleAction{
+				ActionType: aws.String(lifecyclePolicyActionTypeExpire),
+			},
+			RulePriority: aws.Int64(int64(tfMap["priority"].(int))),
+			Selection:    &lifecyclePolicyRuleSelection{},
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Action.ActionType = aws.String(v[0].(map[string]interface{})["type"].(string))
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Selection = expandLifecyclePolicyRuleSelection(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLifecyclePolicyRuleSelection(tfMap map[string]interface{}) *lifecyclePolicyRuleSelection {
+	apiObject := &lifecyclePolicyRuleSelection{}
+
+	if v, ok := tfMap["count_number"].(int); ok && v != 0 {
+		apiObject.CountNumber = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["count_type"].(string); ok && v != "" {
+		apiObject.CountType = aws.String(v)
+	}
+
+	if v, ok := tfMap["count_unit"].(string); ok && v != "" {
+		apiObject.CountUnit = aws.String(v)
+	}
+
+	if v, ok := tfMap["tag_pattern_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagPatternList = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["tag_prefix_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagPrefixList = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["tag_status"].(string); ok && v != "" {
+		apiObject.TagStatus = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECRLifecyclePolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecr_lifecycle_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccLifecyclePolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_lifecyclePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_lifecycle_policy.test"
+	dataSourceName := "data.aws_ecr_lifecycle_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyDocumentDataSourceConfig_lifecyclePolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy", dataSourceName, "json"),
+				),
+			},
+			{
+				Config:   testAccLifecyclePolicyDocumentDataSourceConfig_lifecyclePolicy(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_duplicatePriority,
+				ExpectError: regexache.MustCompile(`rule priority 1 is not unique`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_anyNotLast,
+				ExpectError: regexache.MustCompile(`rule 1: a rule with tag_status "any" must have the highest priority`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_taggedWithoutTags,
+				ExpectError: regexache.MustCompile(`rule 1: exactly one of tag_pattern_list or tag_prefix_list must be set when tag_status is "tagged"`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_sinceImagePushedWithoutUnit,
+				ExpectError: regexache.MustCompile(`rule 1: count_unit must be set when count_type is "sinceImagePushed"`),
+			},
+		},
+	})
+}
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_basic = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 2
+
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 100
+    }
+  }
+
+  rule {
+    priority    = 1
+    description = "Expire untagged images after 14 days"
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_unit   = "days"
+      count_number = 14
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceExpectedJSON_basic = `{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "description": "Expire untagged images after 14 days",
+      "selection": {
+        "tagStatus": "untagged",
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 14
+      },
+      "action": {
+        "type": "expire"
+      }
+    },
+    {
+      "rulePriority": 2,
+      "selection": {
+        "tagStatus": "any",
+        "countType": "imageCountMoreThan",
+        "countNumber": 100
+      },
+      "action": {
+        "type": "expire"
+      }
+    }
+  ]
+}`
+
+func testAccLifecyclePolicyDocumentDataSourceConfig_lifecyclePolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status       = "tagged"
+      tag_pattern_list = ["prod*", "release-*"]
+      count_type       = "imageCountMoreThan"
+      count_number     = 10
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["dev"]
+      count_type      = "sinceImagePushed"
+      count_unit      = "days"
+      count_number    = 7
+    }
+  }
+}
+
+resource "aws_ecr_lifecycle_policy" "test" {
+  repository = aws_ecr_repository.test.name
+  policy     = data.aws_ecr_lifecycle_policy_document.test.json
+}
+`, rName)
+}
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_duplicatePriority = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 100
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_anyNotLast = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 100
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_taggedWithoutTags = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "tagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_sinceImagePushedWithoutUnit = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_number = 14
+    }
+  }
+}
+`
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource(name="Repositories")
+func newDataSourceRepositories(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return framework.NewPluralDataSource(framework.PluralDataSourceSpec[*ecr.Repository]{
+		TypeName:     "aws_ecr_repositories",
+		IDsAttribute: "names",
+		List: func(ctx context.Context, meta *conns.AWSClient, _ tfsdk.Config, fn func(*ecr.Repository) bool) error {
+			conn := meta.ECRConn(ctx)
+
+			return conn.DescribeRepositoriesPagesWithContext(ctx, &ecr.DescribeRepositoriesInput{}, func(page *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Repositories {
+					if !fn(v) {
+						return false
+					}
+				}
+
+				return !lastPage
+			})
+		},
+		ARN: func(_ *conns.AWSClient, v *ecr.Repository) string {
+			return aws.StringValue(v.RepositoryArn)
+		},
+		ID: func(v *ecr.Repository) string {
+			return aws.StringValue(v.RepositoryName)
+		},
+		TaggingResourceType: "ecr:repository",
+	}), nil
+}
@@ -16,7 +16,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceRepositories,
+			Name:    "Repositories",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -33,6 +38,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceImage,
 			TypeName: "aws_ecr_image",
 		},
+		{
+			Factory:  DataSourceLifecyclePolicyDocument,
+			TypeName: "aws_ecr_lifecycle_policy_document",
+		},
 		{
 			Factory:  DataSourcePullThroughCacheRule,
 			TypeName: "aws_ecr_pull_through_cache_rule",
@@ -107,6 +107,40 @@ func ResourceService() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"codedeploy_deployment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"container_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"container_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"deployment_config_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"deployment_group_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"deployment_circuit_breaker": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -472,6 +506,7 @@ func ResourceService() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
 			capacityProviderStrategyCustomizeDiff,
+			codeDeployDeploymentCustomizeDiff,
 			triggersCustomizeDiff,
 		),
 	}
@@ -781,6 +816,9 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
+	// With the CODE_DEPLOY deployment controller, the task definition is changed by a CodeDeploy deployment.
+	codeDeploy := isCodeDeployDeployment(d)
+
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &ecs.UpdateServiceInput{
 			Cluster:            aws.String(d.Get("cluster").(string)),
@@ -909,42 +947,50 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.ServiceRegistries = expandServiceRegistries(d.Get("service_registries").([]interface{}))
 		}
 
-		if d.HasChange("task_definition") {
+		if d.HasChange("task_definition") && !codeDeploy {
 			input.TaskDefinition = aws.String(d.Get("task_definition").(string))
 		}
 
-		// Retry due to IAM eventual consistency
-		err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
-			_, err := conn.UpdateServiceWithContext(ctx, input)
+		if !codeDeploy || d.HasChangesExcept("tags", "tags_all", "codedeploy_deployment", "task_definition") {
+			// Retry due to IAM eventual consistency
+			err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
+				_, err := conn.UpdateServiceWithContext(ctx, input)
 
-			if err != nil {
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
-					return retry.RetryableError(err)
-				}
+				if err != nil {
+					if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
+						return retry.RetryableError(err)
+					}
 
-				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "does not have an associated load balancer") {
-					return retry.RetryableError(err)
+					if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "does not have an associated load balancer") {
+						return retry.RetryableError(err)
+					}
+
+					return retry.NonRetryableError(err)
 				}
+				return nil
+			})
 
-				return retry.NonRetryableError(err)
+			if tfresource.TimedOut(err) {
+				_, err = conn.UpdateServiceWithContext(ctx, input)
 			}
-			return nil
-		})
 
-		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateServiceWithContext(ctx, input)
-		}
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s): %s", d.Id(), err)
+			}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s): %s", d.Id(), err)
+			fn := waitServiceActive
+			if d.Get("wait_for_steady_state").(bool) {
+				fn = waitServiceStable
+			}
+			if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
+			}
 		}
+	}
 
-		fn := waitServiceActive
-		if d.Get("wait_for_steady_state").(bool) {
-			fn = waitServiceStable
-		}
-		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
+	if codeDeploy && d.HasChange("task_definition") {
+		if err := deployServiceWithCodeDeploy(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s) task definition: %s", d.Id(), err)
 		}
 	}
 
@@ -1063,6 +1109,18 @@ func triggersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 	return nil
 }
 
+func codeDeployDeploymentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("codedeploy_deployment"); !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	if v := expandDeploymentController(d.Get("deployment_controller").([]interface{})); v == nil || aws.StringValue(v.Type) != ecs.DeploymentControllerTypeCodeDeploy {
+		return fmt.Errorf("codedeploy_deployment requires a deployment_controller of type %s", ecs.DeploymentControllerTypeCodeDeploy)
+	}
+
+	return nil
+}
+
 func capacityProviderStrategyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// to be backward compatible, should ForceNew almost always (previous behavior), unless:
 	//   force_new_deployment is true and
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfdeploy "github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
+)
+
+// isCodeDeployDeployment returns whether task definition changes to the service are deployed by CodeDeploy.
+func isCodeDeployDeployment(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("codedeploy_deployment"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return false
+	}
+
+	v := expandDeploymentController(d.Get("deployment_controller").([]interface{}))
+
+	return v != nil && aws.StringValue(v.Type) == ecs.DeploymentControllerTypeCodeDeploy
+}
+
+// deployServiceWithCodeDeploy deploys the service's task definition with a CodeDeploy blue/green deployment
+// and waits for the deployment to succeed.
+// If the deployment group reroutes traffic manually, it waits only until the deployment is ready for traffic to be rerouted.
+func deployServiceWithCodeDeploy(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+	deployConn := meta.(*conns.AWSClient).DeployConn(ctx)
+
+	tfMap := d.Get("codedeploy_deployment").([]interface{})[0].(map[string]interface{})
+
+	// The AppSpec requires the task definition ARN.
+	taskDefinition := d.Get("task_definition").(string)
+	output, err := conn.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinition),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading ECS Task Definition (%s): %w", taskDefinition, err)
+	}
+
+	appSpec, err := serviceAppSpecContent(d, aws.StringValue(output.TaskDefinition.TaskDefinitionArn), tfMap["container_name"].(string), tfMap["container_port"].(int))
+
+	if err != nil {
+		return err
+	}
+
+	input := &codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(tfMap["app_name"].(string)),
+		DeploymentGroupName: aws.String(tfMap["deployment_group_name"].(string)),
+		Revision: &codedeploy.RevisionLocation{
+			AppSpecContent: &codedeploy.AppSpecContent{
+				Content: aws.String(appSpec),
+			},
+			RevisionType: aws.String(codedeploy.RevisionLocationTypeAppSpecContent),
+		},
+	}
+
+	if v, ok := tfMap["deployment_config_name"].(string); ok && v != "" {
+		input.DeploymentConfigName = aws.String(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		input.Description = aws.String(v)
+	}
+
+	log.Printf("[DEBUG] Creating CodeDeploy Deployment: %s", input)
+	deployment, err := deployConn.CreateDeploymentWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("creating CodeDeploy Deployment: %w", err)
+	}
+
+	deploymentID := aws.StringValue(deployment.DeploymentId)
+
+	info, err := tfdeploy.WaitDeploymentSucceededOrReady(ctx, deployConn, deploymentID, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("waiting for CodeDeploy Deployment (%s): %w", deploymentID, err)
+	}
+
+	if aws.StringValue(info.Status) == codedeploy.DeploymentStatusReady {
+		log.Printf("[INFO] CodeDeploy Deployment (%s) is waiting for traffic to be rerouted", deploymentID)
+	}
+
+	return nil
+}
+
+// serviceAppSpecContent returns the AppSpec for a CodeDeploy deployment of the service with the specified task definition.
+// See https://docs.aws.amazon.com/codedeploy/latest/userguide/reference-appspec-file-structure-resources.html#reference-appspec-file-structure-resources-ecs.
+func serviceAppSpecContent(d *schema.ResourceData, taskDefinitionARN, containerName string, containerPort int) (string, error) {
+	properties := map[string]interface{}{
+		"LoadBalancerInfo": map[string]interface{}{
+			"ContainerName": containerName,
+			"ContainerPort": containerPort,
+		},
+		"TaskDefinition": taskDefinitionARN,
+	}
+
+	if v, ok := d.GetOk("capacity_provider_strategy"); ok && v.(*schema.Set).Len() > 0 {
+		var tfList []interface{}
+
+		for _, v := range expandCapacityProviderStrategy(v.(*schema.Set)) {
+			tfList = append(tfList, map[string]interface{}{
+				"Base":             aws.Int64Value(v.Base),
+				"CapacityProvider": aws.StringValue(v.CapacityProvider),
+				"Weight":           aws.Int64Value(v.Weight),
+			})
+		}
+
+		properties["CapacityProviderStrategy"] = tfList
+	}
+
+	if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		assignPublicIP := ecs.AssignPublicIpDisabled
+
+		if tfMap["assign_public_ip"].(bool) {
+			assignPublicIP = ecs.AssignPublicIpEnabled
+		}
+
+		properties["NetworkConfiguration"] = map[string]interface{}{
+			"AwsvpcConfiguration": map[string]interface{}{
+				"AssignPublicIp": assignPublicIP,
+				"SecurityGroups": flex.ExpandStringValueSet(tfMap["security_groups"].(*schema.Set)),
+				"Subnets":        flex.ExpandStringValueSet(tfMap["subnets"].(*schema.Set)),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("platform_version"); ok {
+		properties["PlatformVersion"] = v.(string)
+	}
+
+	appSpec := map[string]interface{}{
+		"version": "0.0",
+		"Resources": []interface{}{
+			map[string]interface{}{
+				"TargetService": map[string]interface{}{
+					"Type":       "AWS::ECS::Service",
+					"Properties": properties,
+				},
+			},
+		},
+	}
+
+	b, err := json.Marshal(appSpec)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
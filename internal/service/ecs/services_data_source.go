@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource(name="Services")
+func newDataSourceServices(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return framework.NewPluralDataSource(framework.PluralDataSourceSpec[string]{
+		TypeName:     "aws_ecs_services",
+		IDsAttribute: "names",
+		Attributes: map[string]schema.Attribute{
+			"cluster_arn": schema.StringAttribute{
+				Required: true,
+			},
+			"launch_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ecs.LaunchType_Values()...),
+				},
+			},
+			"scheduling_strategy": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ecs.SchedulingStrategy_Values()...),
+				},
+			},
+		},
+		List: func(ctx context.Context, meta *conns.AWSClient, config tfsdk.Config, fn func(string) bool) error {
+			conn := meta.ECSConn(ctx)
+
+			var clusterARN, launchType, schedulingStrategy types.String
+			for k, v := range map[string]*types.String{
+				"cluster_arn":         &clusterARN,
+				"launch_type":         &launchType,
+				"scheduling_strategy": &schedulingStrategy,
+			} {
+				if diags := config.GetAttribute(ctx, path.Root(k), v); diags.HasError() {
+					return fmt.Errorf("reading %s: %v", k, diags)
+				}
+			}
+
+			input := &ecs.ListServicesInput{
+				Cluster: aws.String(clusterARN.ValueString()),
+			}
+
+			if !launchType.IsNull() {
+				input.LaunchType = aws.String(launchType.ValueString())
+			}
+
+			if !schedulingStrategy.IsNull() {
+				input.SchedulingStrategy = aws.String(schedulingStrategy.ValueString())
+			}
+
+			return conn.ListServicesPagesWithContext(ctx, input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.ServiceArns {
+					if !fn(aws.StringValue(v)) {
+						return false
+					}
+				}
+
+				return !lastPage
+			})
+		},
+		ARN: func(_ *conns.AWSClient, v string) string {
+			return v
+		},
+		ID: func(v string) string {
+			// Service ARNs are of the form arn:aws:ecs:region:account:service/cluster-name/service-name
+			// or, for the old format, arn:aws:ecs:region:account:service/service-name.
+			arn, err := arn.Parse(v)
+
+			if err != nil {
+				return ""
+			}
+
+			parts := strings.Split(arn.Resource, "/")
+
+			return parts[len(parts)-1]
+		},
+		TaggingResourceType: "ecs:service",
+	}), nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource(name="Keys")
+func newDataSourceKeys(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return framework.NewPluralDataSource(framework.PluralDataSourceSpec[*kms.KeyListEntry]{
+		TypeName:     "aws_kms_keys",
+		IDsAttribute: "ids",
+		List: func(ctx context.Context, meta *conns.AWSClient, _ tfsdk.Config, fn func(*kms.KeyListEntry) bool) error {
+			conn := meta.KMSConn(ctx)
+
+			return conn.ListKeysPagesWithContext(ctx, &kms.ListKeysInput{}, func(page *kms.ListKeysOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Keys {
+					if !fn(v) {
+						return false
+					}
+				}
+
+				return !lastPage
+			})
+		},
+		ARN: func(_ *conns.AWSClient, v *kms.KeyListEntry) string {
+			return aws.StringValue(v.KeyArn)
+		},
+		ID: func(v *kms.KeyListEntry) string {
+			return aws.StringValue(v.KeyId)
+		},
+		TaggingResourceType: "kms:key",
+	}), nil
+}
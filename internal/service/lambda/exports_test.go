@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+// Exports for use in tests only.
+var (
+	FindRuntimeManagementConfigByTwoPartKey = findRuntimeManagementConfigByTwoPartKey
+)
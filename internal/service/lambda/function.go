@@ -169,7 +169,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_files"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -208,7 +208,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_files"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -288,10 +288,30 @@ func ResourceFunction() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[types.Runtime](),
 			},
+			"runtime_management_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runtime_version_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"update_runtime_on": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(types.UpdateRuntimeOnAuto),
+							ValidateDiagFunc: enum.Validate[types.UpdateRuntimeOn](),
+						},
+					},
+				},
+			},
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_files"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -302,7 +322,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir", "source_files"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -344,6 +364,43 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_files"},
+			},
+			"source_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"source_files": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_files"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"filename": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source_staging_bucket": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "image_uri", "s3_bucket"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"timeout": {
@@ -415,6 +472,7 @@ func ResourceFunction() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			customizeDiffSourceArchive,
 			checkHandlerRuntimeForZipFunction,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
@@ -459,6 +517,20 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if hasSourceArchive(d) {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, err := expandSourceArchiveCode(ctx, d, meta, functionSourceArchiveKeyPrefix(functionName))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging Lambda Function (%s) source: %s", functionName, err)
+		}
+
+		input.Code.S3Bucket = code.S3Bucket
+		input.Code.S3Key = code.S3Key
+		input.Code.S3ObjectVersion = code.S3ObjectVersion
+		input.Code.ZipFile = code.ZipFile
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -571,6 +643,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandRuntimeManagementConfig(v.([]interface{})[0].(map[string]interface{}))
+		input.FunctionName = aws.String(d.Id())
+
+		_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -647,6 +730,20 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("role", function.Role)
 	d.Set("runtime", function.Runtime)
+	// Only read the runtime management configuration if it's managed by this resource.
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 {
+		output, err := findRuntimeManagementConfigByTwoPartKey(ctx, conn, d.Id(), "")
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+		}
+
+		if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(output)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting runtime_management_config: %s", err)
+		}
+	} else {
+		d.Set("runtime_management_config", nil)
+	}
 	d.Set("signing_job_arn", function.SigningJobArn)
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
@@ -908,6 +1005,20 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if hasSourceArchive(d) {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, err := expandSourceArchiveCode(ctx, d, meta, functionSourceArchiveKeyPrefix(d.Id()))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "packaging Lambda Function (%s) source: %s", d.Id(), err)
+			}
+
+			input.S3Bucket = code.S3Bucket
+			input.S3Key = code.S3Key
+			input.S3ObjectVersion = code.S3ObjectVersion
+			input.ZipFile = code.ZipFile
 		} else {
 			input.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -957,6 +1068,23 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("runtime_management_config") {
+		var tfMap map[string]interface{}
+		if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap = v.([]interface{})[0].(map[string]interface{})
+		}
+
+		// Removing the configuration restores the default.
+		input := expandRuntimeManagementConfig(tfMap)
+		input.FunctionName = aws.String(d.Id())
+
+		_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) runtime management configuration: %s", d.Id(), err)
+		}
+	}
+
 	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		input := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
@@ -1257,6 +1385,10 @@ func needsFunctionConfigUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("ephemeral_storage")
 }
 
+func functionSourceArchiveKeyPrefix(functionName string) string {
+	return fmt.Sprintf("lambda/functions/%s/", functionName)
+}
+
 func readFileContents(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
 	if err != nil {
@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a deployment package uploaded directly in the request.
+	// Larger packages must be uploaded to S3 first.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	codeDirectUploadMaxSize = 50 * 1024 * 1024
+)
+
+// sourceArchiveModTime is the modification time of every file in a source archive.
+// It is the earliest time representable in a ZIP file so that archives built from the same files are identical.
+var sourceArchiveModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type sourceArchiveResourceData interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
+func hasSourceArchive(d sourceArchiveResourceData) bool {
+	if _, ok := d.GetOk("source_dir"); ok {
+		return true
+	}
+
+	if v, ok := d.GetOk("source_files"); ok && len(v.([]interface{})) > 0 {
+		return true
+	}
+
+	return false
+}
+
+// customizeDiffSourceArchive computes the hash of the archive built from the source files during plan,
+// so that any change to the files results in a diff.
+func customizeDiffSourceArchive(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !hasSourceArchive(d) {
+		return nil
+	}
+
+	for _, key := range []string{"source_dir", "source_excludes", "source_files"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("source_code_hash")
+		}
+	}
+
+	content, err := buildSourceArchive(d)
+
+	if err != nil {
+		return err
+	}
+
+	if hash := sourceArchiveHash(content); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
+type sourceArchiveCode struct {
+	S3Bucket        *string
+	S3Key           *string
+	S3ObjectVersion *string
+	ZipFile         []byte
+}
+
+// expandSourceArchiveCode builds the archive from the source files.
+// Archives too large to upload directly are uploaded to the staging bucket under keyPrefix.
+func expandSourceArchiveCode(ctx context.Context, d sourceArchiveResourceData, meta interface{}, keyPrefix string) (*sourceArchiveCode, error) {
+	content, err := buildSourceArchive(d)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sourceArchiveHash(content)
+
+	if v, ok := d.GetOk("source_code_hash"); ok && v.(string) != hash {
+		return nil, fmt.Errorf("source code hash (%s) does not match planned value (%s); source files changed after plan", hash, v)
+	}
+
+	if len(content) <= codeDirectUploadMaxSize {
+		return &sourceArchiveCode{
+			ZipFile: content,
+		}, nil
+	}
+
+	v, ok := d.GetOk("source_staging_bucket")
+
+	if !ok {
+		return nil, fmt.Errorf("source archive size (%d bytes) exceeds the direct upload limit (%d bytes), source_staging_bucket must be set", len(content), codeDirectUploadMaxSize)
+	}
+
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := v.(string)
+	sum := sha256.Sum256(content)
+	key := keyPrefix + hex.EncodeToString(sum[:]) + ".zip"
+	input := &s3.PutObjectInput{
+		Body:          bytes.NewReader(content),
+		Bucket:        aws.String(bucket),
+		ContentLength: int64(len(content)),
+		ContentType:   aws.String("application/zip"),
+		Key:           aws.String(key),
+	}
+
+	output, err := conn.PutObject(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading source archive to S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+	}
+
+	return &sourceArchiveCode{
+		S3Bucket:        aws.String(bucket),
+		S3Key:           aws.String(key),
+		S3ObjectVersion: output.VersionId,
+	}, nil
+}
+
+// sourceArchiveHash returns the hash of an archive in the format returned by the Lambda API.
+func sourceArchiveHash(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+type sourceArchiveEntry struct {
+	content []byte
+	mode    fs.FileMode
+	name    string
+}
+
+func buildSourceArchive(d sourceArchiveResourceData) ([]byte, error) {
+	var entries []sourceArchiveEntry
+
+	if v, ok := d.GetOk("source_dir"); ok {
+		var excludes []string
+		if v, ok := d.GetOk("source_excludes"); ok {
+			excludes = flex.ExpandStringValueSet(v.(*schema.Set))
+		}
+
+		dirEntries, err := readSourceDir(v.(string), excludes)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading source directory (%s): %w", v, err)
+		}
+
+		entries = append(entries, dirEntries...)
+	}
+
+	if v, ok := d.GetOk("source_files"); ok {
+		for _, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			entry, err := readSourceFile(tfMap)
+
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+
+	return writeSourceArchive(entries)
+}
+
+func readSourceDir(dir string, excludes []string) ([]sourceArchiveEntry, error) {
+	dir, err := homedir.Expand(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []sourceArchiveEntry
+
+	err = filepath.WalkDir(dir, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, filename)
+
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		name := filepath.ToSlash(rel)
+
+		for _, pattern := range excludes {
+			if sourceArchiveGlobMatch(pattern, name) {
+				if entry.IsDir() {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to files.
+		info, err := os.Stat(filename)
+
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		content, err := os.ReadFile(filename)
+
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, sourceArchiveEntry{
+			content: content,
+			mode:    info.Mode(),
+			name:    name,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func readSourceFile(tfMap map[string]interface{}) (sourceArchiveEntry, error) {
+	name := tfMap["filename"].(string)
+	content, source := tfMap["content"].(string), tfMap["source"].(string)
+
+	if (content == "") == (source == "") {
+		return sourceArchiveEntry{}, fmt.Errorf("exactly one of content or source must be set for source file (%s)", name)
+	}
+
+	if content != "" {
+		return sourceArchiveEntry{
+			content: []byte(content),
+			name:    name,
+		}, nil
+	}
+
+	filename, err := homedir.Expand(source)
+
+	if err != nil {
+		return sourceArchiveEntry{}, err
+	}
+
+	info, err := os.Stat(filename)
+
+	if err != nil {
+		return sourceArchiveEntry{}, fmt.Errorf("reading source file (%s): %w", source, err)
+	}
+
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return sourceArchiveEntry{}, fmt.Errorf("reading source file (%s): %w", source, err)
+	}
+
+	return sourceArchiveEntry{
+		content: b,
+		mode:    info.Mode(),
+		name:    name,
+	}, nil
+}
+
+// writeSourceArchive writes the entries to a ZIP archive.
+// Entries are sorted by name and file metadata is normalized so that the same files always produce the same archive.
+func writeSourceArchive(entries []sourceArchiveEntry) ([]byte, error) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	names := make(map[string]struct{}, len(entries))
+
+	for _, entry := range entries {
+		name := strings.TrimPrefix(path.Clean(filepath.ToSlash(entry.name)), "/")
+
+		if name == "." || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("invalid source file name (%s)", entry.name)
+		}
+
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("duplicate source file name (%s)", name)
+		}
+		names[name] = struct{}{}
+
+		mode := fs.FileMode(0o644)
+		if entry.mode&0o111 != 0 {
+			mode = 0o755
+		}
+
+		header := &zip.FileHeader{
+			Method:   zip.Deflate,
+			Modified: sourceArchiveModTime,
+			Name:     name,
+		}
+		header.SetMode(mode)
+
+		f, err := w.CreateHeader(header)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := f.Write(entry.content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// sourceArchiveGlobMatch reports whether a slash-separated file name matches a glob pattern.
+// Path segments are matched as for path.Match and a "**" segment matches zero or more segments.
+func sourceArchiveGlobMatch(pattern, name string) bool {
+	return sourceArchiveGlobMatchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func sourceArchiveGlobMatchSegments(patterns, names []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			for i := 0; i <= len(names); i++ {
+				if sourceArchiveGlobMatchSegments(patterns[1:], names[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(names) == 0 {
+			return false
+		}
+
+		if ok, err := path.Match(patterns[0], names[0]); err != nil || !ok {
+			return false
+		}
+
+		patterns, names = patterns[1:], names[1:]
+	}
+
+	return len(names) == 0
+}
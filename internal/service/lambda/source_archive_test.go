@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSourceArchiveGlobMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{pattern: "*.py", name: "main.py", expected: true},
+		{pattern: "*.py", name: "pkg/main.py", expected: false},
+		{pattern: "**/*.py", name: "main.py", expected: true},
+		{pattern: "**/*.py", name: "pkg/sub/main.py", expected: true},
+		{pattern: "tests", name: "tests", expected: true},
+		{pattern: "tests", name: "pkg/tests", expected: false},
+		{pattern: "**/__pycache__", name: "pkg/__pycache__", expected: true},
+		{pattern: "node_modules/**", name: "node_modules/a/index.js", expected: true},
+		{pattern: "node_modules/**", name: "src/index.js", expected: false},
+		{pattern: "?.txt", name: "a.txt", expected: true},
+		{pattern: "[", name: "[", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := sourceArchiveGlobMatch(testCase.pattern, testCase.name); got != testCase.expected {
+			t.Errorf("sourceArchiveGlobMatch(%q, %q) = %t, expected %t", testCase.pattern, testCase.name, got, testCase.expected)
+		}
+	}
+}
+
+func TestReadSourceDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.js":                "exports.handler = async () => {};",
+		"lib/util.js":             "module.exports = {};",
+		"node_modules/a/index.js": "module.exports = 1;",
+		"README.md":               "# README",
+	} {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.Chmod(filepath.Join(dir, "index.js"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := readSourceDir(dir, []string{"node_modules", "*.md"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	content, err := writeSourceArchive(entries)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]os.FileMode{
+		"index.js":    0o755,
+		"lib/util.js": 0o644,
+	}
+
+	if got, want := len(r.File), len(expected); got != want {
+		t.Fatalf("got %d files, expected %d", got, want)
+	}
+
+	for _, f := range r.File {
+		mode, ok := expected[f.Name]
+
+		if !ok {
+			t.Errorf("unexpected file %s", f.Name)
+			continue
+		}
+
+		if got := f.Mode().Perm(); got != mode {
+			t.Errorf("file %s: got mode %s, expected %s", f.Name, got, mode)
+		}
+
+		if !f.Modified.Equal(sourceArchiveModTime) {
+			t.Errorf("file %s: got modification time %s, expected %s", f.Name, f.Modified, sourceArchiveModTime)
+		}
+	}
+}
+
+func TestWriteSourceArchive(t *testing.T) {
+	t.Parallel()
+
+	entries := func() []sourceArchiveEntry {
+		return []sourceArchiveEntry{
+			{name: "b.txt", content: []byte("b")},
+			{name: "a.txt", content: []byte("a"), mode: 0o600},
+		}
+	}
+
+	content1, err := writeSourceArchive(entries())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	reversed := entries()
+	reversed[0], reversed[1] = reversed[1], reversed[0]
+
+	content2, err := writeSourceArchive(reversed)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := sourceArchiveHash(content2), sourceArchiveHash(content1); got != want {
+		t.Errorf("got hash %s, expected %s", got, want)
+	}
+
+	if _, err := writeSourceArchive([]sourceArchiveEntry{{name: "a.txt"}, {name: "./a.txt"}}); err == nil {
+		t.Error("expected error for duplicate file name")
+	}
+
+	if _, err := writeSourceArchive([]sourceArchiveEntry{{name: "../a.txt"}}); err == nil {
+		t.Error("expected error for file name outside archive")
+	}
+}
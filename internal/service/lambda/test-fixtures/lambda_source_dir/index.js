@@ -0,0 +1,5 @@
+var util = require('./lib/util');
+
+exports.example = function(event, context, callback) {
+  callback(null, util.message);
+};
@@ -16,6 +16,7 @@ import (
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/exp/slices"
 )
 
@@ -36,7 +37,6 @@ func ResourceSharingWithOrganization() *schema.Resource {
 
 const (
 	sharingWithOrganizationRoleName = "AWSServiceRoleForResourceAccessManager"
-	servicePrincipalName            = "ram.amazonaws.com"
 )
 
 func resourceSharingWithOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -82,6 +82,7 @@ func resourceSharingWithOrganizationRead(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "reading Organization service principals: %s", err)
 	}
 
+	servicePrincipalName := meta.(*conns.AWSClient).ServicePrincipal(names.RAM)
 	enabled := slices.Contains(servicePrincipalNames, servicePrincipalName)
 
 	if !d.IsNewResource() && !enabled {
@@ -98,6 +99,7 @@ func resourceSharingWithOrganizationDelete(ctx context.Context, d *schema.Resour
 
 	// See https://docs.aws.amazon.com/ram/latest/userguide/security-disable-sharing-with-orgs.html.
 
+	servicePrincipalName := meta.(*conns.AWSClient).ServicePrincipal(names.RAM)
 	if err := tforganizations.DisableServicePrincipal(ctx, meta.(*conns.AWSClient).OrganizationsConn(ctx), servicePrincipalName); err != nil {
 		return sdkdiag.AppendErrorf(diags, "disabling Organization service principal (%s): %s", servicePrincipalName, err)
 	}
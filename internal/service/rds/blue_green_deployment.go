@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_rds_blue_green_deployment", name="Blue/Green Deployment")
+func ResourceBlueGreenDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBlueGreenDeploymentCreate,
+		ReadWithoutTimeout:   resourceBlueGreenDeploymentRead,
+		UpdateWithoutTimeout: resourceBlueGreenDeploymentUpdate,
+		DeleteWithoutTimeout: resourceBlueGreenDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blue_green_deployment_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delete_source": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delete_target": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"switchover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"switchover_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(30),
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_db_cluster_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"target_db_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"target_engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBlueGreenDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutCreate))
+
+	name := d.Get("blue_green_deployment_name").(string)
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(name),
+		Source:                  aws.String(d.Get("source").(string)),
+	}
+
+	if v, ok := d.GetOk("target_db_cluster_parameter_group_name"); ok {
+		input.TargetDBClusterParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_db_parameter_group_name"); ok {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_engine_version"); ok {
+		input.TargetEngineVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateBlueGreenDeployment(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Blue/Green Deployment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier))
+
+	if _, err := waitBlueGreenDeploymentAvailable(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Blue/Green Deployment (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("switchover").(bool) {
+		if err := blueGreenDeploymentSwitchover(ctx, conn, d.Id(), d.Get("switchover_timeout").(int), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "switching over RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("delete_source").(bool) {
+			if err := deleteBlueGreenDeploymentSource(ctx, meta.(*conns.AWSClient).RDSConn(ctx), conn, d.Id(), deadline.Remaining()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting RDS Blue/Green Deployment (%s) source: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceBlueGreenDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	dep, err := findBlueGreenDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Blue/Green Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	switchedOver := aws.StringValue(dep.Status) == blueGreenDeploymentStatusSwitchoverCompleted
+	imported := d.Get("source").(string) == ""
+
+	d.Set("blue_green_deployment_name", dep.BlueGreenDeploymentName)
+	switch {
+	case !switchedOver:
+		d.Set("source", dep.Source)
+	case imported:
+		// After switchover, the source is the renamed Blue environment and the
+		// Green environment has the identifier of the original source.
+		d.Set("source", dep.Target)
+	}
+	d.Set("status", dep.Status)
+	d.Set("target", dep.Target)
+
+	// After switchover the Green environment is the production environment. Its later upgrades and
+	// parameter group changes must not force a new deployment, so its settings are no longer refreshed.
+	if !switchedOver || imported || d.IsNewResource() {
+		if err := readBlueGreenDeploymentTarget(ctx, d, meta.(*conns.AWSClient).RDSConn(ctx), aws.StringValue(dep.Target)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS Blue/Green Deployment (%s) target: %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+func resourceBlueGreenDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+	switchedOver := d.Get("status").(string) == blueGreenDeploymentStatusSwitchoverCompleted
+
+	if d.HasChange("switchover") && d.Get("switchover").(bool) && !switchedOver {
+		if err := blueGreenDeploymentSwitchover(ctx, conn, d.Id(), d.Get("switchover_timeout").(int), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "switching over RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+
+		switchedOver = true
+	}
+
+	if d.HasChanges("delete_source", "switchover") && d.Get("delete_source").(bool) && switchedOver {
+		if err := deleteBlueGreenDeploymentSource(ctx, meta.(*conns.AWSClient).RDSConn(ctx), conn, d.Id(), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting RDS Blue/Green Deployment (%s) source: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceBlueGreenDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(d.Id()),
+	}
+
+	// The Green environment cannot be deleted once it has been switched over.
+	if d.Get("delete_target").(bool) && d.Get("status").(string) != blueGreenDeploymentStatusSwitchoverCompleted {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", d.Id())
+	_, err := conn.DeleteBlueGreenDeployment(ctx, input)
+
+	if errs.IsA[*types.BlueGreenDeploymentNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Blue/Green Deployment (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// blueGreenDeploymentSwitchover switches over the specified Blue/Green Deployment and waits for the switchover to complete.
+// switchoverTimeout is the number of seconds RDS allows for the switchover; zero uses the service default.
+func blueGreenDeploymentSwitchover(ctx context.Context, conn *rds_sdkv2.Client, id string, switchoverTimeout int, timeout time.Duration) error {
+	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	}
+
+	if switchoverTimeout > 0 {
+		input.SwitchoverTimeout = aws.Int32(int32(switchoverTimeout))
+	}
+
+	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
+		func() (interface{}, error) {
+			return conn.SwitchoverBlueGreenDeployment(ctx, input)
+		},
+		func(err error) (bool, error) {
+			return errs.IsA[*types.InvalidBlueGreenDeploymentStateFault](err), err
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitBlueGreenDeploymentSwitchoverCompleted(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+// deleteBlueGreenDeploymentSource deletes the Blue environment of a switched over Blue/Green Deployment without final snapshots.
+func deleteBlueGreenDeploymentSource(ctx context.Context, conn *rds.RDS, connV2 *rds_sdkv2.Client, id string, timeout time.Duration) error {
+	dep, err := findBlueGreenDeploymentByID(ctx, connV2, id)
+
+	if err != nil {
+		return err
+	}
+
+	source := aws.StringValue(dep.Source)
+
+	if v, err := parseDBClusterARN(source); err == nil {
+		err := deleteBlueGreenSourceCluster(ctx, conn, v.Identifier, timeout)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	v, err := parseDBInstanceARN(source)
+
+	if err != nil {
+		return err
+	}
+
+	return deleteBlueGreenSourceInstance(ctx, conn, v.Identifier, timeout)
+}
+
+// deleteBlueGreenSourceInstance deletes the DB instance that was the source of a switched over Blue/Green Deployment without a final snapshot.
+func deleteBlueGreenSourceInstance(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	instance, err := findDBInstanceByIDSDKv1(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if aws.BoolValue(instance.DeletionProtection) {
+		input := &rds.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceIdentifier: aws.String(id),
+			DeletionProtection:   aws.Bool(false),
+		}
+
+		if _, err := conn.ModifyDBInstanceWithContext(ctx, input); err != nil {
+			return fmt.Errorf("disabling deletion protection: %w", err)
+		}
+
+		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, id, deadline.Remaining()); err != nil {
+			return fmt.Errorf("disabling deletion protection: waiting for completion: %w", err)
+		}
+	}
+
+	input := &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+		SkipFinalSnapshot:    aws.Bool(true),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, deadline.Remaining(),
+		func() (interface{}, error) {
+			return conn.DeleteDBInstanceWithContext(ctx, input)
+		},
+		errCodeInvalidParameterCombination, "disable deletion pro",
+	)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS DB Instance (%s): %w", id, err)
+	}
+
+	if _, err := waitDBInstanceDeleted(ctx, conn, id, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for RDS DB Instance (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
+
+// readBlueGreenDeploymentTarget sets the engine version and parameter groups of the Green environment.
+// Nothing is set if the Green environment no longer exists.
+func readBlueGreenDeploymentTarget(ctx context.Context, d *schema.ResourceData, conn *rds.RDS, target string) error {
+	var instanceID string
+
+	if v, err := parseDBClusterARN(target); err == nil {
+		cluster, err := FindDBClusterByID(ctx, conn, v.Identifier)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		d.Set("target_db_cluster_parameter_group_name", cluster.DBClusterParameterGroup)
+		d.Set("target_engine_version", cluster.EngineVersion)
+
+		for _, v := range cluster.DBClusterMembers {
+			if aws.BoolValue(v.IsClusterWriter) {
+				instanceID = aws.StringValue(v.DBInstanceIdentifier)
+				break
+			}
+		}
+
+		if instanceID == "" {
+			return nil
+		}
+	} else {
+		v, err := parseDBInstanceARN(target)
+
+		if err != nil {
+			return err
+		}
+
+		instanceID = v.Identifier
+	}
+
+	instance, err := findDBInstanceByIDSDKv1(ctx, conn, instanceID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(instance.DBParameterGroups) > 0 && instance.DBParameterGroups[0] != nil {
+		d.Set("target_db_parameter_group_name", instance.DBParameterGroups[0].DBParameterGroupName)
+	}
+	if instance.DBClusterIdentifier == nil {
+		d.Set("target_engine_version", instance.EngineVersion)
+	}
+
+	return nil
+}
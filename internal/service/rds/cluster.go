@@ -5,12 +5,14 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -29,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -90,6 +93,19 @@ func ResourceCluster() *schema.Resource {
 				Default:      1,
 				ValidateFunc: validation.IntAtMost(35),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"backtrack_window": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -521,6 +537,25 @@ func ResourceCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				if engine := d.Get("engine").(string); !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+
+				if d.Get("replication_source_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+
+				return nil
+			},
 			customdiff.ForceNewIf("storage_type", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				// Aurora supports mutation of the storage_type parameter, other engines do not
 				return !strings.HasPrefix(d.Get("engine").(string), "aurora")
@@ -1219,14 +1254,29 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
-	if d.HasChangesExcept(
+	exceptAttributes := []string{
 		"allow_major_version_upgrade",
+		"blue_green_update",
 		"final_snapshot_identifier",
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		"tags", "tags_all") {
+		"tags", "tags_all",
+	}
+
+	// Engine version and parameter group changes are made in a Blue/Green Deployment,
+	// then any other changes are made to the new cluster.
+	blueGreen := d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(clusterBlueGreenUpdateAttributes...)
+	if blueGreen {
+		if diags = clusterBlueGreenUpdate(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+
+		exceptAttributes = append(exceptAttributes, clusterBlueGreenUpdateAttributes...)
+	}
+
+	if d.HasChangesExcept(exceptAttributes...) {
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
 			DBClusterIdentifier: aws.String(d.Id()),
@@ -1256,7 +1306,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if d.HasChange("db_cluster_parameter_group_name") && !blueGreen {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
@@ -1265,7 +1315,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		// set, the configured attribute should always be sent on modify.
 		// Except, this causes an error on a minor version upgrade, so it is
 		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		if v, ok := d.GetOk("db_instance_parameter_group_name"); !blueGreen && (ok || d.HasChange("db_instance_parameter_group_name")) {
 			input.DBInstanceParameterGroupName = aws.String(v.(string))
 		}
 
@@ -1292,15 +1342,17 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		if d.HasChange("engine_version") {
-			input.EngineVersion = aws.String(d.Get("engine_version").(string))
-		}
+		if !blueGreen {
+			if d.HasChange("engine_version") {
+				input.EngineVersion = aws.String(d.Get("engine_version").(string))
+			}
 
-		// This can happen when updates are deferred (apply_immediately = false), and
-		// multiple applies occur before the maintenance window. In this case,
-		// continue sending the desired engine_version as part of the modify request.
-		if d.Get("engine_version").(string) != d.Get("engine_version_actual").(string) {
-			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+			// This can happen when updates are deferred (apply_immediately = false), and
+			// multiple applies occur before the maintenance window. In this case,
+			// continue sending the desired engine_version as part of the modify request.
+			if d.Get("engine_version").(string) != d.Get("engine_version_actual").(string) {
+				input.EngineVersion = aws.String(d.Get("engine_version").(string))
+			}
 		}
 
 		if d.HasChange("iam_database_authentication_enabled") {
@@ -1449,6 +1501,186 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+// clusterBlueGreenUpdateAttributes are the attributes whose changes are made in a Blue/Green Deployment when blue_green_update is enabled.
+var clusterBlueGreenUpdateAttributes = []string{
+	"db_cluster_parameter_group_name",
+	"db_instance_parameter_group_name",
+	"engine_version",
+}
+
+// clusterBlueGreenUpdate makes engine version and parameter group changes to the cluster in a Blue/Green Deployment.
+// The Green environment is created with the changes, then switched over, and the Blue environment is deleted.
+// After switchover, the Green cluster and its instances have the identifiers of the Blue cluster and instances.
+func clusterBlueGreenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+	connV2 := meta.(*conns.AWSClient).RDSClient(ctx)
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+	orchestrator := newBlueGreenOrchestrator(connV2)
+	handler := newClusterHandler(connV2)
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
+	dep, err := orchestrator.createDeployment(ctx, handler.createBlueGreenInput(d))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	deploymentIdentifier := aws.StringValue(dep.BlueGreenDeploymentIdentifier)
+	defer func() {
+		// Ensure that the Blue/Green Deployment is always cleaned up.
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+
+		input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: aws.String(deploymentIdentifier),
+		}
+		if dep == nil || aws.StringValue(dep.Status) != blueGreenDeploymentStatusSwitchoverCompleted {
+			input.DeleteTarget = aws.Bool(true)
+		}
+
+		if _, err := connV2.DeleteBlueGreenDeployment(ctx, input); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: %s", d.Id(), err)
+			return
+		}
+
+		if _, err := waitBlueGreenDeploymentDeleted(ctx, connV2, deploymentIdentifier, deadline.Remaining()); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: waiting for completion: %s", d.Id(), err)
+		}
+	}()
+
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, deploymentIdentifier, deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	targetARN, err := parseDBClusterARN(aws.StringValue(dep.Target))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
+	}
+	if _, err := waitDBClusterUpdated(ctx, conn, targetARN.Identifier, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
+	dep, err = orchestrator.switchover(ctx, deploymentIdentifier, deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", d.Id())
+	sourceARN, err := parseDBClusterARN(aws.StringValue(dep.Source))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+	if err := deleteBlueGreenSourceCluster(ctx, conn, sourceARN.Identifier, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// deleteBlueGreenSourceCluster deletes the cluster that was the source of a switched over Blue/Green Deployment, and its instances, without final snapshots.
+func deleteBlueGreenSourceCluster(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	cluster, err := FindDBClusterByID(ctx, conn, id)
+	if err != nil {
+		return err
+	}
+
+	if aws.BoolValue(cluster.DeletionProtection) {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(id),
+			DeletionProtection:  aws.Bool(false),
+		}
+
+		if _, err := conn.ModifyDBClusterWithContext(ctx, input); err != nil {
+			return fmt.Errorf("disabling deletion protection: %w", err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, conn, id, deadline.Remaining()); err != nil {
+			return fmt.Errorf("disabling deletion protection: waiting for completion: %w", err)
+		}
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		instanceID := aws.StringValue(v.DBInstanceIdentifier)
+		input := &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+			SkipFinalSnapshot:    aws.Bool(true),
+		}
+
+		if _, err := conn.DeleteDBInstanceWithContext(ctx, input); err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+			return fmt.Errorf("deleting RDS DB Instance (%s): %w", instanceID, err)
+		}
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		instanceID := aws.StringValue(v.DBInstanceIdentifier)
+
+		if _, err := waitDBInstanceDeleted(ctx, conn, instanceID, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for RDS DB Instance (%s) delete: %w", instanceID, err)
+		}
+	}
+
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+		SkipFinalSnapshot:   aws.Bool(true),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, deadline.Remaining(),
+		func() (interface{}, error) {
+			return conn.DeleteDBClusterWithContext(ctx, input)
+		},
+		rds.ErrCodeInvalidDBClusterStateFault, "is not currently in the available state",
+	)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS Cluster (%s): %w", id, err)
+	}
+
+	if _, err := waitDBClusterDeleted(ctx, conn, id, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for RDS Cluster (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
+
+type dbClusterARN struct {
+	arn.ARN
+	Identifier string
+}
+
+func parseDBClusterARN(s string) (dbClusterARN, error) {
+	arn, err := arn.Parse(s)
+	if err != nil {
+		return dbClusterARN{}, err
+	}
+
+	result := dbClusterARN{
+		ARN: arn,
+	}
+
+	re := regexache.MustCompile(`^cluster:([0-9a-z-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if matches == nil || len(matches) != 2 {
+		return dbClusterARN{}, errors.New("DB Cluster ARN: invalid resource section")
+	}
+	result.Identifier = matches[1]
+
+	return result, nil
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
@@ -7,6 +7,10 @@ import (
 	"time"
 )
 
+const (
+	blueGreenDeploymentStatusSwitchoverCompleted = "SWITCHOVER_COMPLETED"
+)
+
 const (
 	ClusterRoleStatusActive  = "ACTIVE"
 	ClusterRoleStatusDeleted = "DELETED"
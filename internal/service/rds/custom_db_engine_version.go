@@ -0,0 +1,459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rds_custom_db_engine_version", name="Custom DB Engine Version")
+// @Tags(identifierAttribute="arn")
+func ResourceCustomDBEngineVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomDBEngineVersionCreate,
+		ReadWithoutTimeout:   resourceCustomDBEngineVersionRead,
+		UpdateWithoutTimeout: resourceCustomDBEngineVersionUpdate,
+		DeleteWithoutTimeout: resourceCustomDBEngineVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCustomDBEngineVersionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_installation_files_s3_bucket_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"database_installation_files_s3_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"db_parameter_group_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 35),
+			},
+			"engine_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidKMSKeyID,
+			},
+			"major_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Import reads the manifest from RDS, which also returns one for CEVs created without a manifest.
+					if new == "" && d.Id() != "" {
+						return true
+					}
+
+					return verify.SuppressEquivalentJSONDiffs(k, old, new, d)
+				},
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"manifest_computed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(rds.CustomEngineVersionStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	engine := d.Get("engine").(string)
+	engineVersion := d.Get("engine_version").(string)
+	id := CustomDBEngineVersionCreateResourceID(engine, engineVersion)
+	input := &rds.CreateCustomDBEngineVersionInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("database_installation_files_s3_bucket_name"); ok {
+		input.DatabaseInstallationFilesS3BucketName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("database_installation_files_s3_prefix"); ok {
+		input.DatabaseInstallationFilesS3Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		input.ImageId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KMSKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok {
+		input.Manifest = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateCustomDBEngineVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Custom DB Engine Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitCustomDBEngineVersionCreated(ctx, conn, engine, engineVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Custom DB Engine Version (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) != rds.CustomEngineVersionStatusAvailable {
+		input := &rds.ModifyCustomDBEngineVersionInput{
+			Engine:        aws.String(engine),
+			EngineVersion: aws.String(engineVersion),
+			Status:        aws.String(v.(string)),
+		}
+
+		if _, err := conn.ModifyCustomDBEngineVersionWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Custom DB Engine Version (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCustomDBEngineVersionRead(ctx, d, meta)...)
+}
+
+func resourceCustomDBEngineVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Custom DB Engine Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.DBEngineVersionArn)
+	if output.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("database_installation_files_s3_bucket_name", output.DatabaseInstallationFilesS3BucketName)
+	d.Set("database_installation_files_s3_prefix", output.DatabaseInstallationFilesS3Prefix)
+	d.Set("db_parameter_group_family", output.DBParameterGroupFamily)
+	d.Set("description", output.DBEngineVersionDescription)
+	d.Set("engine", output.Engine)
+	d.Set("engine_version", output.EngineVersion)
+	if output.Image != nil {
+		d.Set("image_id", output.Image.ImageId)
+	} else {
+		d.Set("image_id", nil)
+	}
+	// RDS returns the key ARN, whichever key identifier is configured.
+	if d.Get("kms_key_id").(string) == "" {
+		d.Set("kms_key_id", output.KMSKeyId)
+	}
+	d.Set("major_engine_version", output.MajorEngineVersion)
+	if output.CustomDBEngineVersionManifest != nil {
+		manifest, err := structure.NormalizeJsonString(aws.StringValue(output.CustomDBEngineVersionManifest))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("manifest_computed", manifest)
+	} else {
+		d.Set("manifest_computed", nil)
+	}
+	d.Set("status", output.Status)
+
+	setTagsOut(ctx, output.TagList)
+
+	return diags
+}
+
+func resourceCustomDBEngineVersionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading RDS Custom DB Engine Version (%s): %w", d.Id(), err)
+	}
+
+	// The manifest isn't otherwise read, so start from the manifest returned by RDS
+	// rather than forcing replacement of the imported CEV.
+	if output.CustomDBEngineVersionManifest != nil {
+		manifest, err := structure.NormalizeJsonString(aws.StringValue(output.CustomDBEngineVersionManifest))
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.Set("manifest", manifest)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceCustomDBEngineVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	if d.HasChanges("description", "status") {
+		engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &rds.ModifyCustomDBEngineVersionInput{
+			Engine:        aws.String(engine),
+			EngineVersion: aws.String(engineVersion),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if _, err := conn.ModifyCustomDBEngineVersionWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCustomDBEngineVersionRead(ctx, d, meta)...)
+}
+
+func resourceCustomDBEngineVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	engine, engineVersion, err := CustomDBEngineVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Custom DB Engine Version: %s", d.Id())
+	_, err = conn.DeleteCustomDBEngineVersionWithContext(ctx, &rds.DeleteCustomDBEngineVersionInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCustomDBEngineVersionNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Custom DB Engine Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCustomDBEngineVersionDeleted(ctx, conn, engine, engineVersion, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Custom DB Engine Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCustomDBEngineVersionByTwoPartKey(ctx context.Context, conn *rds.RDS, engine, engineVersion string) (*rds.DBEngineVersion, error) {
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+		IncludeAll:    aws.Bool(true), // Required to return CEVs that are not "available".
+	}
+
+	output, err := conn.DescribeDBEngineVersionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeCustomDBEngineVersionNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.DBEngineVersions {
+		if v == nil {
+			continue
+		}
+
+		if aws.StringValue(v.Engine) == engine && aws.StringValue(v.EngineVersion) == engineVersion {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func statusCustomDBEngineVersion(ctx context.Context, conn *rds.RDS, engine, engineVersion string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+const (
+	customDBEngineVersionStatusCreating          = "creating"
+	customDBEngineVersionStatusDeleting          = "deleting"
+	customDBEngineVersionStatusPendingValidation = "pending-validation"
+)
+
+func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{customDBEngineVersionStatusCreating},
+		// RDS Custom for SQL Server CEVs remain pending validation until the first DB instance is created from them.
+		Target:     []string{rds.CustomEngineVersionStatusAvailable, customDBEngineVersionStatusPendingValidation},
+		Refresh:    statusCustomDBEngineVersion(ctx, conn, engine, engineVersion),
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomDBEngineVersionDeleted(ctx context.Context, conn *rds.RDS, engine, engineVersion string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{customDBEngineVersionStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusCustomDBEngineVersion(ctx, conn, engine, engineVersion),
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBEngineVersion); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Custom DB Engine Versions for RDS Custom for SQL Server are created from an AMI
+// that has SQL Server installed, so the tests require an existing AMI.
+const envVarCustomDBEngineVersionImageID = "RDS_CUSTOM_SQLSERVER_AMI_ID"
+
+func TestAccRDSCustomDBEngineVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	imageID := os.Getenv(envVarCustomDBEngineVersionImageID)
+	if imageID == "" {
+		t.Skipf("Environment variable %s is not set", envVarCustomDBEngineVersionImageID)
+	}
+
+	var v rds.DBEngineVersion
+	rName := fmt.Sprintf("15.00.4249.2.%s", sdkacctest.RandString(8))
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDBEngineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig_basic(rName, imageID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexache.MustCompile(`cev:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "custom-sqlserver-ee"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", rName),
+					resource.TestCheckResourceAttr(resourceName, "image_id", imageID),
+					resource.TestCheckResourceAttr(resourceName, "status", "pending-validation"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+		},
+	})
+}
+
+func TestAccRDSCustomDBEngineVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	imageID := os.Getenv(envVarCustomDBEngineVersionImageID)
+	if imageID == "" {
+		t.Skipf("Environment variable %s is not set", envVarCustomDBEngineVersionImageID)
+	}
+
+	var v rds.DBEngineVersion
+	rName := fmt.Sprintf("15.00.4249.2.%s", sdkacctest.RandString(8))
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDBEngineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig_basic(rName, imageID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrds.ResourceCustomDBEngineVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomDBEngineVersionExists(ctx context.Context, n string, v *rds.DBEngineVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		engine, engineVersion, err := tfrds.CustomDBEngineVersionParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn(ctx)
+
+		output, err := tfrds.FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCustomDBEngineVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_custom_db_engine_version" {
+				continue
+			}
+
+			engine, engineVersion, err := tfrds.CustomDBEngineVersionParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfrds.FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RDS Custom DB Engine Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCustomDBEngineVersionConfig_basic(rName, imageID string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_custom_db_engine_version" "test" {
+  engine         = "custom-sqlserver-ee"
+  engine_version = %[1]q
+  image_id       = %[2]q
+}
+`, rName, imageID)
+}
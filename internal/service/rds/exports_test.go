@@ -5,7 +5,9 @@ package rds
 
 // Exports for use in tests only.
 var (
-	FindDBInstanceByID = findDBInstanceByIDSDKv1
+	FindBlueGreenDeploymentByID = findBlueGreenDeploymentByID
+	FindDBInstanceByID          = findDBInstanceByIDSDKv1
+	WaitDBInstanceDeleted       = waitDBInstanceDeleted
 
 	ListTags = listTags
 )
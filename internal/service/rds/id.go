@@ -34,3 +34,22 @@ func ClusterRoleAssociationParseResourceID(id string) (string, string, error) {
 
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DBCLUSTERID%[2]sROLEARN", id, clusterRoleAssociationResourceIDSeparator)
 }
+
+const customDBEngineVersionResourceIDSeparator = ":"
+
+func CustomDBEngineVersionCreateResourceID(engine, engineVersion string) string {
+	parts := []string{engine, engineVersion}
+	id := strings.Join(parts, customDBEngineVersionResourceIDSeparator)
+
+	return id
+}
+
+func CustomDBEngineVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, customDBEngineVersionResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ENGINE%[2]sENGINEVERSION", id, customDBEngineVersionResourceIDSeparator)
+}
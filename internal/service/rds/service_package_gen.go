@@ -190,6 +190,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceBlueGreenDeployment,
+			TypeName: "aws_rds_blue_green_deployment",
+			Name:     "Blue/Green Deployment",
+		},
 		{
 			Factory:  ResourceCluster,
 			TypeName: "aws_rds_cluster",
@@ -230,6 +235,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceClusterRoleAssociation,
 			TypeName: "aws_rds_cluster_role_association",
 		},
+		{
+			Factory:  ResourceCustomDBEngineVersion,
+			TypeName: "aws_rds_custom_db_engine_version",
+			Name:     "Custom DB Engine Version",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceGlobalCluster,
 			TypeName: "aws_rds_global_cluster",
@@ -8,6 +8,8 @@ var (
 	CIDRLocationParseResourceID  = cidrLocationParseResourceID
 	FindCIDRCollectionByID       = findCIDRCollectionByID
 	FindCIDRLocationByTwoPartKey = findCIDRLocationByTwoPartKey
+	FindHostedZoneRecordSetsByID = findHostedZoneRecordSetsByID
 	ResourceCIDRCollection       = newResourceCIDRCollection
 	ResourceCIDRLocation         = newResourceCIDRLocation
+	ResourceRecordSetChanges     = resourceRecordSetChanges
 )
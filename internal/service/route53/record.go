@@ -301,25 +301,29 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		action = route53.ChangeActionCreate
 	}
 
-	// Create the new records. We abuse StateChangeConf for this to
-	// retry for us since Route53 sometimes returns errors about another
-	// operation happening at the same time.
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(action),
-				ResourceRecordSet: rec,
-			},
-		},
+	change := &route53.Change{
+		Action:            aws.String(action),
+		ResourceRecordSet: rec,
 	}
+	hostedZoneID := CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))
 
-	input := &route53.ChangeResourceRecordSetsInput{
-		ChangeBatch:  changeBatch,
-		HostedZoneId: aws.String(CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))),
+	var changeInfo *route53.ChangeInfo
+	if d.IsNewResource() {
+		// Creates of many records in the same zone are coalesced into fewer requests.
+		changeInfo, err = createResourceRecordSet(ctx, conn, hostedZoneID, change)
+	} else {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: aws.String("Managed by Terraform"),
+				Changes: []*route53.Change{change},
+			},
+			HostedZoneId: aws.String(hostedZoneID),
+		}
+
+		changeInfo, err = ChangeResourceRecordSets(ctx, conn, input)
 	}
 
-	changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
+	meta.(*conns.AWSClient).Route53RecordSetCache().Invalidate(hostedZoneID)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Route 53 Record: %s", err)
@@ -339,14 +343,30 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) create: %s", d.Id(), err)
 	}
 
-	return append(diags, resourceRecordRead(ctx, d, meta)...)
+	return append(diags, readRecord(ctx, d, meta, false)...)
 }
 
 func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readRecord(ctx, d, meta, true)
+}
+
+// readRecord reads the record into state.
+// On refresh, once several records in the same hosted zone have been read, the record is looked up in the
+// cached listing of the hosted zone's record sets, so that refreshing many records in the same zone doesn't
+// exceed the Route 53 rate limit. Otherwise, and after a change, the record is looked up directly.
+func readRecord(ctx context.Context, d *schema.ResourceData, meta interface{}, useCache bool) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
 
-	record, fqdn, err := FindResourceRecordSetByFourPartKey(ctx, conn, CleanZoneID(d.Get("zone_id").(string)), d.Get("name").(string), d.Get("type").(string), d.Get("set_identifier").(string))
+	var record *route53.ResourceRecordSet
+	var fqdn string
+	var err error
+	zoneID, name, recordType, setIdentifier := CleanZoneID(d.Get("zone_id").(string)), d.Get("name").(string), d.Get("type").(string), d.Get("set_identifier").(string)
+	if cache := meta.(*conns.AWSClient).Route53RecordSetCache(); useCache && cache.UseListing(zoneID) {
+		record, fqdn, err = findCachedResourceRecordSetByFourPartKey(ctx, conn, cache, zoneID, name, recordType, setIdentifier)
+	} else {
+		record, fqdn, err = FindResourceRecordSetByFourPartKey(ctx, conn, zoneID, name, recordType, setIdentifier)
+	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route 53 Record (%s) not found, removing from state", d.Id())
@@ -603,6 +623,8 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
 
+	meta.(*conns.AWSClient).Route53RecordSetCache().Invalidate(zone)
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Route 53 resource record sets: %s", err)
 	}
@@ -623,7 +645,7 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) update: %s", d.Id(), err)
 	}
 
-	return append(diags, resourceRecordRead(ctx, d, meta)...)
+	return append(diags, readRecord(ctx, d, meta, false)...)
 }
 
 func resourceRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -667,6 +689,8 @@ func resourceRecordDelete(ctx context.Context, d *schema.ResourceData, meta inte
 
 	respRaw, err := DeleteRecordSet(ctx, conn, input)
 
+	meta.(*conns.AWSClient).Route53RecordSetCache().Invalidate(zoneID)
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Record (%s): %s", d.Id(), err)
 	}
@@ -711,13 +735,7 @@ func FindResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Route
 		}
 
 		for _, v := range page.ResourceRecordSets {
-			if recordName != strings.ToLower(CleanRecordName(aws.StringValue(v.Name))) {
-				continue
-			}
-			if recordType != strings.ToUpper(aws.StringValue(v.Type)) {
-				continue
-			}
-			if recordSetIdentifier != aws.StringValue(v.SetIdentifier) {
+			if !resourceRecordSetMatches(v, recordName, recordType, recordSetIdentifier) {
 				continue
 			}
 
@@ -748,6 +766,80 @@ func FindResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Route
 	return output, fqdn, nil
 }
 
+// findCachedResourceRecordSetByFourPartKey finds a record set in the cached listing of its hosted zone's record sets,
+// listing all the hosted zone's record sets if they aren't cached.
+func findCachedResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Route53, cache *conns.Route53RecordSetCache, zoneID, recordName, recordType, recordSetIdentifier string) (*route53.ResourceRecordSet, string, error) {
+	zone, err := cache.Get(ctx, zoneID, func(ctx context.Context) (*conns.Route53HostedZoneRecordSets, error) {
+		return findHostedZoneRecordSetsByID(ctx, conn, zoneID)
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	fqdn := ExpandRecordName(recordName, aws.StringValue(zone.HostedZone.Name))
+	recordName = FQDN(strings.ToLower(fqdn))
+
+	for _, v := range zone.RecordSets {
+		if resourceRecordSetMatches(v, recordName, recordType, recordSetIdentifier) {
+			return v, fqdn, nil
+		}
+	}
+
+	return nil, "", &retry.NotFoundError{}
+}
+
+func findHostedZoneRecordSetsByID(ctx context.Context, conn *route53.Route53, zoneID string) (*conns.Route53HostedZoneRecordSets, error) {
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	output := &conns.Route53HostedZoneRecordSets{
+		HostedZone: zone.HostedZone,
+	}
+
+	err = conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output.RecordSets = append(output.RecordSets, page.ResourceRecordSets...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// resourceRecordSetMatches returns whether a record set has the specified lower case fully qualified name, type and set identifier.
+func resourceRecordSetMatches(apiObject *route53.ResourceRecordSet, recordName, recordType, recordSetIdentifier string) bool {
+	if recordName != strings.ToLower(CleanRecordName(aws.StringValue(apiObject.Name))) {
+		return false
+	}
+
+	if recordType != strings.ToUpper(aws.StringValue(apiObject.Type)) {
+		return false
+	}
+
+	return recordSetIdentifier == aws.StringValue(apiObject.SetIdentifier)
+}
+
 func ChangeResourceRecordSets(ctx context.Context, conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeInfo, error) {
 	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, func() (interface{}, error) {
 		return conn.ChangeResourceRecordSetsWithContext(ctx, input)
@@ -789,8 +881,40 @@ func DeleteRecordSet(ctx context.Context, conn *route53.Route53, input *route53.
 }
 
 func expandResourceRecordSet(d *schema.ResourceData, zoneName string) *route53.ResourceRecordSet {
+	tfMap := map[string]interface{}{
+		"name": d.Get("name"),
+		"type": d.Get("type"),
+	}
+
+	for _, k := range []string{
+		"alias",
+		"cidr_routing_policy",
+		"failover_routing_policy",
+		"geolocation_routing_policy",
+		"health_check_id",
+		"latency_routing_policy",
+		"multivalue_answer_routing_policy",
+		"records",
+		"set_identifier",
+		"ttl",
+		"weighted_routing_policy",
+	} {
+		if v, ok := d.GetOk(k); ok {
+			tfMap[k] = v
+		}
+	}
+
+	return expandResourceRecordSetFromMap(tfMap, zoneName)
+}
+
+// expandResourceRecordSetFromMap expands a record set from a map with the attributes of an aws_route53_record resource.
+// Zero values are treated as not set.
+func expandResourceRecordSetFromMap(tfMap map[string]interface{}, zoneName string) *route53.ResourceRecordSet {
+	name, _ := tfMap["name"].(string)
+	recordType, _ := tfMap["type"].(string)
+
 	// get expanded name
-	en := ExpandRecordName(d.Get("name").(string), zoneName)
+	en := ExpandRecordName(name, zoneName)
 
 	// Create the RecordSet request with the fully expanded name, e.g.
 	// sub.domain.com. Route 53 requires a fully qualified domain name, but does
@@ -798,23 +922,21 @@ func expandResourceRecordSet(d *schema.ResourceData, zoneName string) *route53.R
 	// here.
 	rec := &route53.ResourceRecordSet{
 		Name: aws.String(en),
-		Type: aws.String(d.Get("type").(string)),
+		Type: aws.String(recordType),
 	}
 
-	if v, ok := d.GetOk("ttl"); ok {
-		rec.TTL = aws.Int64(int64(v.(int)))
+	if v, ok := tfMap["ttl"].(int); ok && v != 0 {
+		rec.TTL = aws.Int64(int64(v))
 	}
 
 	// Resource records
-	if v, ok := d.GetOk("records"); ok {
-		recs := v.(*schema.Set).List()
-		rec.ResourceRecords = expandResourceRecords(recs, d.Get("type").(string))
+	if v, ok := tfMap["records"].(*schema.Set); ok && v.Len() > 0 {
+		rec.ResourceRecords = expandResourceRecords(v.List(), recordType)
 	}
 
 	// Alias record
-	if v, ok := d.GetOk("alias"); ok {
-		aliases := v.([]interface{})
-		alias := aliases[0].(map[string]interface{})
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := v[0].(map[string]interface{})
 		rec.AliasTarget = &route53.AliasTarget{
 			DNSName:              aws.String(alias["name"].(string)),
 			EvaluateTargetHealth: aws.Bool(alias["evaluate_target_health"].(bool)),
@@ -822,9 +944,8 @@ func expandResourceRecordSet(d *schema.ResourceData, zoneName string) *route53.R
 		}
 	}
 
-	if v, ok := d.GetOk("cidr_routing_policy"); ok {
-		records := v.([]interface{})
-		cidr := records[0].(map[string]interface{})
+	if v, ok := tfMap["cidr_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cidr := v[0].(map[string]interface{})
 
 		rec.CidrRoutingConfig = &route53.CidrRoutingConfig{
 			CollectionId: aws.String(cidr["collection_id"].(string)),
@@ -832,16 +953,14 @@ func expandResourceRecordSet(d *schema.ResourceData, zoneName string) *route53.R
 		}
 	}
 
-	if v, ok := d.GetOk("failover_routing_policy"); ok {
-		records := v.([]interface{})
-		failover := records[0].(map[string]interface{})
+	if v, ok := tfMap["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		failover := v[0].(map[string]interface{})
 
 		rec.Failover = aws.String(failover["type"].(string))
 	}
 
-	if v, ok := d.GetOk("geolocation_routing_policy"); ok {
-		geolocations := v.([]interface{})
-		geolocation := geolocations[0].(map[string]interface{})
+	if v, ok := tfMap["geolocation_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		geolocation := v[0].(map[string]interface{})
 
 		rec.GeoLocation = &route53.GeoLocation{
 			ContinentCode:   nilString(geolocation["continent"].(string)),
@@ -850,28 +969,26 @@ func expandResourceRecordSet(d *schema.ResourceData, zoneName string) *route53.R
 		}
 	}
 
-	if v, ok := d.GetOk("health_check_id"); ok {
-		rec.HealthCheckId = aws.String(v.(string))
+	if v, ok := tfMap["health_check_id"].(string); ok && v != "" {
+		rec.HealthCheckId = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("latency_routing_policy"); ok {
-		records := v.([]interface{})
-		latency := records[0].(map[string]interface{})
+	if v, ok := tfMap["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		latency := v[0].(map[string]interface{})
 
 		rec.Region = aws.String(latency["region"].(string))
 	}
 
-	if v, ok := d.GetOk("multivalue_answer_routing_policy"); ok {
-		rec.MultiValueAnswer = aws.Bool(v.(bool))
+	if v, ok := tfMap["multivalue_answer_routing_policy"].(bool); ok && v {
+		rec.MultiValueAnswer = aws.Bool(v)
 	}
 
-	if v, ok := d.GetOk("set_identifier"); ok {
-		rec.SetIdentifier = aws.String(v.(string))
+	if v, ok := tfMap["set_identifier"].(string); ok && v != "" {
+		rec.SetIdentifier = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("weighted_routing_policy"); ok {
-		records := v.([]interface{})
-		weight := records[0].(map[string]interface{})
+	if v, ok := tfMap["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		weight := v[0].(map[string]interface{})
 
 		rec.Weight = aws.Int64(int64(weight["weight"].(int)))
 	}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+const (
+	// recordSetChangeBatchWindow is how long a record set create waits for
+	// creates of other record sets in the same hosted zone to join its change batch.
+	// A create is submitted without waiting if no other create in the hosted zone is pending.
+	recordSetChangeBatchWindow = 500 * time.Millisecond
+	// recordSetChangeBatchTimeout bounds the submission of a change batch, which isn't tied to the
+	// lifetime of any one of the creates in it.
+	recordSetChangeBatchTimeout = 5 * time.Minute
+	// recordSetChangeBatchMaxSize keeps change batches well below the Route 53
+	// limits of 1,000 changes and 32,000 characters of record values per request.
+	recordSetChangeBatchMaxSize = 100
+)
+
+// recordSetChanges coalesces record set creates in the same hosted zone into
+// a single ChangeResourceRecordSets call, reducing API calls under the Route 53 rate limit.
+var recordSetChanges = newRecordSetChangeBatcher(recordSetChangeBatchWindow, recordSetChangeBatchMaxSize)
+
+type recordSetChangeSubmitFunc func(context.Context, []*route53.Change) (*route53.ChangeInfo, error)
+
+type recordSetChangeBatcher struct {
+	lock    sync.Mutex
+	batches map[string]*recordSetChangeBatch
+	maxSize int
+	pending map[string]int
+	window  time.Duration
+}
+
+type recordSetChangeBatch struct {
+	changeInfo *route53.ChangeInfo
+	changes    []*route53.Change
+	done       chan struct{}
+	err        error
+	flush      sync.Once
+	records    map[string]struct{}
+}
+
+func newRecordSetChangeBatcher(window time.Duration, maxSize int) *recordSetChangeBatcher {
+	return &recordSetChangeBatcher{
+		batches: make(map[string]*recordSetChangeBatch),
+		maxSize: maxSize,
+		pending: make(map[string]int),
+		window:  window,
+	}
+}
+
+// submit adds a change to the pending change batch for the specified key, typically an API client and hosted zone,
+// and waits for the batch to be submitted.
+// A change is submitted immediately if no other change for the key is pending.
+// Each record set can appear only once in a change batch, so a change to a record set already in the pending batch is submitted on its own.
+// Route 53 applies a change batch atomically. If a batch of several changes fails, each change is resubmitted on its own
+// so that any error is reported against the record set that caused it.
+// If ctx is canceled before the batch is submitted, the change is withdrawn from the batch. Once the batch is being
+// submitted the change can't be withdrawn, so the batch's result is reported.
+func (b *recordSetChangeBatcher) submit(ctx context.Context, key string, change *route53.Change, submit recordSetChangeSubmitFunc) (*route53.ChangeInfo, error) {
+	record := recordSetChangeKey(change.ResourceRecordSet)
+
+	b.lock.Lock()
+	b.pending[key]++
+	defer b.release(key)
+
+	batch, ok := b.batches[key]
+	if !ok && b.pending[key] == 1 {
+		b.lock.Unlock()
+
+		return submit(ctx, []*route53.Change{change})
+	}
+
+	if ok {
+		if _, ok := batch.records[record]; ok {
+			b.lock.Unlock()
+
+			return submit(ctx, []*route53.Change{change})
+		}
+	} else {
+		batch = &recordSetChangeBatch{
+			done:    make(chan struct{}),
+			records: make(map[string]struct{}),
+		}
+		b.batches[key] = batch
+
+		// The batch is submitted on behalf of all its changes, so it mustn't be canceled with the context of this one.
+		ctx := withoutCancel(ctx)
+		time.AfterFunc(b.window, func() {
+			b.flush(ctx, key, batch, submit)
+		})
+	}
+	batch.changes = append(batch.changes, change)
+	batch.records[record] = struct{}{}
+	full := len(batch.changes) >= b.maxSize
+	b.lock.Unlock()
+
+	if full {
+		go b.flush(withoutCancel(ctx), key, batch, submit)
+	}
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		if b.withdraw(key, batch, change, record) {
+			return nil, ctx.Err()
+		}
+
+		<-batch.done
+	}
+
+	if batch.err != nil && len(batch.changes) > 1 {
+		return submit(ctx, []*route53.Change{change})
+	}
+
+	return batch.changeInfo, batch.err
+}
+
+func (b *recordSetChangeBatcher) flush(ctx context.Context, key string, batch *recordSetChangeBatch, submit recordSetChangeSubmitFunc) {
+	batch.flush.Do(func() {
+		b.lock.Lock()
+		if b.batches[key] == batch {
+			delete(b.batches, key)
+		}
+		b.lock.Unlock()
+
+		defer close(batch.done)
+
+		// All the changes in the batch may have been withdrawn.
+		if len(batch.changes) == 0 {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, recordSetChangeBatchTimeout)
+		defer cancel()
+
+		batch.changeInfo, batch.err = submit(ctx, batch.changes)
+	})
+}
+
+// withdraw removes a change from the specified batch if the batch is still pending, returning whether it did so.
+func (b *recordSetChangeBatcher) withdraw(key string, batch *recordSetChangeBatch, change *route53.Change, record string) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	// A batch is removed from the pending batches before it is submitted.
+	if b.batches[key] != batch {
+		return false
+	}
+
+	for i, v := range batch.changes {
+		if v == change {
+			batch.changes = append(batch.changes[:i], batch.changes[i+1:]...)
+			break
+		}
+	}
+	delete(batch.records, record)
+
+	return true
+}
+
+// release marks a change for the specified key as no longer pending.
+func (b *recordSetChangeBatcher) release(key string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.pending[key]--; b.pending[key] <= 0 {
+		delete(b.pending, key)
+	}
+}
+
+// valueOnlyContext carries the values of its parent context but not its deadline or cancellation.
+type valueOnlyContext struct {
+	context.Context
+}
+
+func withoutCancel(ctx context.Context) context.Context {
+	return valueOnlyContext{ctx}
+}
+
+func (valueOnlyContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (valueOnlyContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (valueOnlyContext) Err() error {
+	return nil
+}
+
+func recordSetChangeKey(apiObject *route53.ResourceRecordSet) string {
+	return strings.Join([]string{
+		strings.ToLower(FQDN(CleanRecordName(aws.StringValue(apiObject.Name)))),
+		strings.ToUpper(aws.StringValue(apiObject.Type)),
+		aws.StringValue(apiObject.SetIdentifier),
+	}, "|")
+}
+
+// createResourceRecordSet creates a record set, batching the change with creates of other record sets in the same hosted zone.
+func createResourceRecordSet(ctx context.Context, conn *route53.Route53, zoneID string, change *route53.Change) (*route53.ChangeInfo, error) {
+	key := fmt.Sprintf("%p/%s", conn, zoneID)
+
+	return recordSetChanges.submit(ctx, key, change, func(ctx context.Context, changes []*route53.Change) (*route53.ChangeInfo, error) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: aws.String("Managed by Terraform"),
+				Changes: changes,
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		return ChangeResourceRecordSets(ctx, conn, input)
+	})
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func testRecordSetChange(name string) *route53.Change {
+	return &route53.Change{
+		Action: aws.String(route53.ChangeActionCreate),
+		ResourceRecordSet: &route53.ResourceRecordSet{
+			Name: aws.String(name),
+			Type: aws.String(route53.RRTypeA),
+		},
+	}
+}
+
+type testRecordSetChangeSubmitter struct {
+	lock    sync.Mutex
+	batches [][]*route53.Change
+	fail    func([]*route53.Change) bool
+}
+
+func (s *testRecordSetChangeSubmitter) submit(_ context.Context, changes []*route53.Change) (*route53.ChangeInfo, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.batches = append(s.batches, changes)
+
+	if s.fail != nil && s.fail(changes) {
+		return nil, errors.New("InvalidChangeBatch")
+	}
+
+	return &route53.ChangeInfo{Id: aws.String(fmt.Sprintf("C%d", len(s.batches)))}, nil
+}
+
+// testRecordSetChangeInFlight submits a change for the specified key that stays pending until the returned function is called,
+// so that subsequent changes for the key are batched.
+func testRecordSetChangeInFlight(ctx context.Context, batcher *recordSetChangeBatcher, key string) func() {
+	submitted, release, done := make(chan struct{}), make(chan struct{}), make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		batcher.submit(ctx, key, testRecordSetChange("in-flight.example.com"), func(context.Context, []*route53.Change) (*route53.ChangeInfo, error) { //nolint:errcheck // test helper
+			close(submitted)
+			<-release
+
+			return &route53.ChangeInfo{}, nil
+		})
+	}()
+
+	<-submitted
+
+	return func() {
+		close(release)
+		<-done
+	}
+}
+
+func TestRecordSetChangeBatcher_single(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batcher := newRecordSetChangeBatcher(time.Hour, 100)
+	submitter := &testRecordSetChangeSubmitter{}
+
+	changeInfo, err := batcher.submit(ctx, "Z1", testRecordSetChange("r.example.com"), submitter.submit)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(changeInfo.Id), "C1"; got != want {
+		t.Errorf("got change ID %q, expected %q", got, want)
+	}
+}
+
+func TestRecordSetChangeBatcher_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batcher := newRecordSetChangeBatcher(100*time.Millisecond, 100)
+	submitter := &testRecordSetChangeSubmitter{}
+	submit := func(ctx context.Context, changes []*route53.Change) (*route53.ChangeInfo, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		return submitter.submit(ctx, changes)
+	}
+	defer testRecordSetChangeInFlight(ctx, batcher, "Z1")()
+
+	// The change that opens the batch is canceled before the batch is submitted, so it's withdrawn from the batch.
+	canceledCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		batcher.submit(canceledCtx, "Z1", testRecordSetChange("r0.example.com"), submit) //nolint:errcheck // canceled
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	if _, err := batcher.submit(ctx, "Z1", testRecordSetChange("r1.example.com"), submit); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	wg.Wait()
+
+	if got, want := len(submitter.batches), 1; got != want {
+		t.Fatalf("got %d requests, expected %d", got, want)
+	}
+
+	if got, want := len(submitter.batches[0]), 1; got != want {
+		t.Fatalf("got %d changes in batch, expected %d", got, want)
+	}
+
+	if got, want := aws.StringValue(submitter.batches[0][0].ResourceRecordSet.Name), "r1.example.com"; got != want {
+		t.Errorf("got change to %q in batch, expected %q", got, want)
+	}
+}
+
+func TestRecordSetChangeBatcher_canceledDuringSubmit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batcher := newRecordSetChangeBatcher(10*time.Millisecond, 100)
+	submitted, release := make(chan struct{}), make(chan struct{})
+	submit := func(ctx context.Context, changes []*route53.Change) (*route53.ChangeInfo, error) {
+		close(submitted)
+		<-release
+
+		return &route53.ChangeInfo{Id: aws.String("C1")}, nil
+	}
+	defer testRecordSetChangeInFlight(ctx, batcher, "Z1")()
+
+	// Once the batch is being submitted, a canceled change reports the batch's result.
+	canceledCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		<-submitted
+		cancel()
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+
+	changeInfo, err := batcher.submit(canceledCtx, "Z1", testRecordSetChange("r.example.com"), submit)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(changeInfo.Id), "C1"; got != want {
+		t.Errorf("got change ID %q, expected %q", got, want)
+	}
+}
+
+func TestRecordSetChangeBatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batcher := newRecordSetChangeBatcher(100*time.Millisecond, 100)
+	submitter := &testRecordSetChangeSubmitter{}
+	defer testRecordSetChangeInFlight(ctx, batcher, "Z1")()
+
+	var wg sync.WaitGroup
+	changeIDs := make([]string, 5)
+	for i := range changeIDs {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			changeInfo, err := batcher.submit(ctx, "Z1", testRecordSetChange(fmt.Sprintf("r%d.example.com", i)), submitter.submit)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			changeIDs[i] = aws.StringValue(changeInfo.Id)
+		}()
+	}
+	wg.Wait()
+
+	if got, want := len(submitter.batches), 1; got != want {
+		t.Fatalf("got %d requests, expected %d", got, want)
+	}
+
+	if got, want := len(submitter.batches[0]), 5; got != want {
+		t.Errorf("got %d changes in batch, expected %d", got, want)
+	}
+
+	for i, v := range changeIDs {
+		if v != "C1" {
+			t.Errorf("change %d: got change ID %q, expected %q", i, v, "C1")
+		}
+	}
+}
+
+func TestRecordSetChangeBatcher_maxSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batcher := newRecordSetChangeBatcher(time.Hour, 2)
+	submitter := &testRecordSetChangeSubmitter{}
+	defer testRecordSetChangeInFlight(ctx, batcher, "Z1")()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := batcher.submit(ctx, "Z1", testRecordSetChange(fmt.Sprintf("r%d.example.com", i)), submitter.submit); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := len(submitter.batches), 1; got != want {
+		t.Errorf("got %d requests, expected %d", got, want)
+	}
+}
+
+func TestRecordSetChangeBatcher_duplicateRecord(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batcher := newRecordSetChangeBatcher(100*time.Millisecond, 100)
+	submitter := &testRecordSetChangeSubmitter{}
+	defer testRecordSetChangeInFlight(ctx, batcher, "Z1")()
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"r.example.com", "R.example.com."} {
+		name := name
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := batcher.submit(ctx, "Z1", testRecordSetChange(name), submitter.submit); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := len(submitter.batches), 2; got != want {
+		t.Errorf("got %d requests, expected %d", got, want)
+	}
+}
+
+func TestRecordSetChangeBatcher_fallback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batcher := newRecordSetChangeBatcher(100*time.Millisecond, 100)
+	submitter := &testRecordSetChangeSubmitter{
+		fail: func(changes []*route53.Change) bool {
+			for _, v := range changes {
+				if aws.StringValue(v.ResourceRecordSet.Name) == "bad.example.com" {
+					return true
+				}
+			}
+			return false
+		},
+	}
+	defer testRecordSetChangeInFlight(ctx, batcher, "Z1")()
+
+	var wg sync.WaitGroup
+	errs := make(map[string]error)
+	var lock sync.Mutex
+	for _, name := range []string{"good.example.com", "bad.example.com"} {
+		name := name
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := batcher.submit(ctx, "Z1", testRecordSetChange(name), submitter.submit)
+			lock.Lock()
+			errs[name] = err
+			lock.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if err := errs["good.example.com"]; err != nil {
+		t.Errorf("good record: unexpected error: %s", err)
+	}
+
+	if err := errs["bad.example.com"]; err == nil {
+		t.Error("bad record: expected error")
+	}
+
+	// One failed batch, then one request per change.
+	if got, want := len(submitter.batches), 3; got != want {
+		t.Errorf("got %d requests, expected %d", got, want)
+	}
+}
@@ -0,0 +1,660 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_route53_records")
+func ResourceRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecordsCreate,
+		ReadWithoutTimeout:   resourceRecordsRead,
+		UpdateWithoutTimeout: resourceRecordsUpdate,
+		DeleteWithoutTimeout: resourceRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("zone_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(strings.TrimSuffix(v.(string), "."))
+				},
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"zone_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 32),
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"location_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"failover_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(route53.ResourceRecordSetFailover_Values(), false),
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"latency_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"multivalue_answer_routing_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+						"weighted_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weight": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+				},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	d.SetId(zoneID)
+
+	if err := applyRecords(ctx, d, meta, zoneID, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Records (%s): %s", zoneID, err)
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zone, err := meta.(*conns.AWSClient).Route53RecordSetCache().Get(ctx, d.Id(), func(ctx context.Context) (*conns.Route53HostedZoneRecordSets, error) {
+		return findHostedZoneRecordSetsByID(ctx, conn, d.Id())
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing Route 53 Records from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	scope := expandRecordsScope(d, zoneName)
+
+	// Keep the configured form of names that Route 53 normalizes, e.g. names relative to the zone.
+	prior := make(map[string]map[string]interface{})
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		prior[recordSetChangeKey(expandResourceRecordSetFromMap(tfMap, zoneName))] = tfMap
+	}
+
+	// All record sets in scope are reported, so that records created outside Terraform show as drift.
+	var tfList []interface{}
+	for _, apiObject := range zone.RecordSets {
+		if !scope.contains(apiObject) {
+			continue
+		}
+
+		tfMap := flattenResourceRecordSet(apiObject)
+
+		if v, ok := prior[recordSetChangeKey(apiObject)]; ok {
+			tfMap["name"] = v["name"]
+
+			if old, new := v["alias"].([]interface{}), tfMap["alias"].([]interface{}); len(old) > 0 && old[0] != nil && len(new) > 0 {
+				if oldName, newMap := old[0].(map[string]interface{})["name"].(string), new[0].(map[string]interface{}); NormalizeAliasName(oldName) == newMap["name"] {
+					newMap["name"] = oldName
+				}
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	if err := d.Set("record", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+	d.Set("zone_id", d.Id())
+
+	return diags
+}
+
+func resourceRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := applyRecords(ctx, d, meta, d.Id(), false); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zone, err := findHostedZoneRecordSetsByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	// Only the records in state are deleted.
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	managed := make(map[string]struct{})
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		managed[recordSetChangeKey(expandResourceRecordSetFromMap(tfMap, zoneName))] = struct{}{}
+	}
+
+	scope := expandRecordsScope(d, zoneName)
+	var changes []*route53.Change
+	for _, apiObject := range zone.RecordSets {
+		if !scope.contains(apiObject) {
+			continue
+		}
+
+		if _, ok := managed[recordSetChangeKey(apiObject)]; !ok {
+			continue
+		}
+
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: apiObject,
+		})
+	}
+
+	log.Printf("[INFO] Deleting Route 53 Records: %s", d.Id())
+	if err := changeResourceRecordSetsInChunks(ctx, conn, d.Id(), changes); err != nil {
+		meta.(*conns.AWSClient).Route53RecordSetCache().Invalidate(d.Id())
+
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	meta.(*conns.AWSClient).Route53RecordSetCache().Invalidate(d.Id())
+
+	return diags
+}
+
+// applyRecords makes the record sets in scope in the hosted zone match the configured records.
+// Record sets in scope that are not configured are deleted only if they were in the prior scope, where Read reports them
+// and so the plan shows their deletion. Any others, e.g. all of them on create or those brought into scope by a change
+// to name_suffix or types, are not deleted and an error is returned instead.
+func applyRecords(ctx context.Context, d *schema.ResourceData, meta interface{}, zoneID string, create bool) error {
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zone, err := findHostedZoneRecordSetsByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("reading Route 53 Hosted Zone (%s) record sets: %w", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	scope := expandRecordsScope(d, zoneName)
+
+	var priorScope *recordsScope
+	if !create {
+		nameSuffix, _ := d.GetChange("name_suffix")
+		types, _ := d.GetChange("types")
+		priorScope = newRecordsScope(zoneName, nameSuffix.(string), types.(*schema.Set))
+	}
+
+	var desired []*route53.ResourceRecordSet
+	desiredKeys := make(map[string]struct{})
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := expandResourceRecordSetFromMap(tfMap, zoneName)
+
+		if !scope.contains(apiObject) {
+			return fmt.Errorf("record %s (%s) is outside the scope of the resource", aws.StringValue(apiObject.Name), aws.StringValue(apiObject.Type))
+		}
+
+		key := recordSetChangeKey(apiObject)
+		if _, ok := desiredKeys[key]; ok {
+			return fmt.Errorf("record %s (%s) with set identifier %q is configured more than once", aws.StringValue(apiObject.Name), aws.StringValue(apiObject.Type), aws.StringValue(apiObject.SetIdentifier))
+		}
+		desiredKeys[key] = struct{}{}
+
+		desired = append(desired, apiObject)
+	}
+
+	var current []*route53.ResourceRecordSet
+	var unmanaged []string
+	for _, apiObject := range zone.RecordSets {
+		if !scope.contains(apiObject) {
+			continue
+		}
+
+		if _, ok := desiredKeys[recordSetChangeKey(apiObject)]; !ok && (priorScope == nil || !priorScope.contains(apiObject)) {
+			unmanaged = append(unmanaged, fmt.Sprintf("%s (%s)", aws.StringValue(apiObject.Name), aws.StringValue(apiObject.Type)))
+		}
+
+		current = append(current, apiObject)
+	}
+
+	if len(unmanaged) > 0 {
+		return fmt.Errorf("records in the scope of the resource are not configured: %s. Configure or import them, or narrow the scope with name_suffix or types", strings.Join(unmanaged, ", "))
+	}
+
+	err = changeResourceRecordSetsInChunks(ctx, conn, zoneID, resourceRecordSetChanges(current, desired))
+
+	meta.(*conns.AWSClient).Route53RecordSetCache().Invalidate(zoneID)
+
+	return err
+}
+
+// recordsScope is the set of record sets in a hosted zone owned by an aws_route53_records resource.
+type recordsScope struct {
+	nameSuffix string
+	types      map[string]struct{}
+	zoneName   string
+}
+
+func expandRecordsScope(d *schema.ResourceData, zoneName string) *recordsScope {
+	return newRecordsScope(zoneName, d.Get("name_suffix").(string), d.Get("types").(*schema.Set))
+}
+
+func newRecordsScope(zoneName, nameSuffix string, types *schema.Set) *recordsScope {
+	scope := &recordsScope{
+		zoneName: strings.ToLower(FQDN(zoneName)),
+	}
+
+	if nameSuffix != "" {
+		scope.nameSuffix = strings.ToLower(FQDN(nameSuffix))
+	}
+
+	if types != nil && types.Len() > 0 {
+		scope.types = make(map[string]struct{})
+		for _, v := range flex.ExpandStringValueSet(types) {
+			scope.types[v] = struct{}{}
+		}
+	}
+
+	return scope
+}
+
+// contains returns whether the specified record set is in scope.
+// The NS and SOA record sets at the zone apex, which Route 53 creates with the hosted zone, are never in scope.
+func (s *recordsScope) contains(apiObject *route53.ResourceRecordSet) bool {
+	name := strings.ToLower(FQDN(CleanRecordName(aws.StringValue(apiObject.Name))))
+	recordType := strings.ToUpper(aws.StringValue(apiObject.Type))
+
+	if name == s.zoneName && (recordType == route53.RRTypeNs || recordType == route53.RRTypeSoa) {
+		return false
+	}
+
+	if s.nameSuffix != "" && name != s.nameSuffix && !strings.HasSuffix(name, "."+s.nameSuffix) {
+		return false
+	}
+
+	if s.types != nil {
+		if _, ok := s.types[recordType]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resourceRecordSetChanges returns the changes that make the current record sets match the desired record sets.
+// Deletions come first so that a record set can be replaced by one of a conflicting type, e.g. a CNAME by an A record.
+func resourceRecordSetChanges(current, desired []*route53.ResourceRecordSet) []*route53.Change {
+	currentByKey := make(map[string]*route53.ResourceRecordSet, len(current))
+	for _, v := range current {
+		currentByKey[recordSetChangeKey(v)] = v
+	}
+
+	desiredByKey := make(map[string]*route53.ResourceRecordSet, len(desired))
+	for _, v := range desired {
+		desiredByKey[recordSetChangeKey(v)] = v
+	}
+
+	var deletes, upserts []*route53.Change
+	for _, v := range current {
+		if _, ok := desiredByKey[recordSetChangeKey(v)]; !ok {
+			deletes = append(deletes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: v,
+			})
+		}
+	}
+
+	for _, v := range desired {
+		old, ok := currentByKey[recordSetChangeKey(v)]
+
+		switch {
+		case !ok:
+			upserts = append(upserts, &route53.Change{
+				Action:            aws.String(route53.ChangeActionCreate),
+				ResourceRecordSet: v,
+			})
+		case !resourceRecordSetsEqual(old, v):
+			upserts = append(upserts, &route53.Change{
+				Action:            aws.String(route53.ChangeActionUpsert),
+				ResourceRecordSet: v,
+			})
+		}
+	}
+
+	return append(deletes, upserts...)
+}
+
+// resourceRecordSetsEqual returns whether two record sets with the same name, type and set identifier are equivalent.
+// Only the attributes managed by the resource are compared, so that attributes set by Route 53 don't cause updates.
+func resourceRecordSetsEqual(a, b *route53.ResourceRecordSet) bool {
+	return reflect.DeepEqual(normalizeResourceRecordSet(a), normalizeResourceRecordSet(b))
+}
+
+// normalizeResourceRecordSet returns a copy of the record set containing only the attributes managed by the resource.
+func normalizeResourceRecordSet(apiObject *route53.ResourceRecordSet) *route53.ResourceRecordSet {
+	output := route53.ResourceRecordSet{
+		CidrRoutingConfig: apiObject.CidrRoutingConfig,
+		Failover:          apiObject.Failover,
+		GeoLocation:       apiObject.GeoLocation,
+		HealthCheckId:     apiObject.HealthCheckId,
+		MultiValueAnswer:  apiObject.MultiValueAnswer,
+		Name:              aws.String(strings.ToLower(FQDN(CleanRecordName(aws.StringValue(apiObject.Name))))),
+		Region:            apiObject.Region,
+		SetIdentifier:     apiObject.SetIdentifier,
+		TTL:               apiObject.TTL,
+		Type:              apiObject.Type,
+		Weight:            apiObject.Weight,
+	}
+
+	if !aws.BoolValue(output.MultiValueAnswer) {
+		output.MultiValueAnswer = nil
+	}
+
+	if apiObject.AliasTarget != nil {
+		aliasTarget := *apiObject.AliasTarget
+		aliasTarget.DNSName = aws.String(NormalizeAliasName(aws.StringValue(aliasTarget.DNSName)))
+		output.AliasTarget = &aliasTarget
+	}
+
+	values := make([]string, 0, len(apiObject.ResourceRecords))
+	for _, v := range apiObject.ResourceRecords {
+		values = append(values, aws.StringValue(v.Value))
+	}
+	sort.Strings(values)
+	output.ResourceRecords = nil
+	for _, v := range values {
+		output.ResourceRecords = append(output.ResourceRecords, &route53.ResourceRecord{Value: aws.String(v)})
+	}
+
+	return &output
+}
+
+// changeResourceRecordSetsInChunks submits changes to a hosted zone's record sets in change batches of limited size.
+// Route 53 applies each change batch atomically.
+func changeResourceRecordSetsInChunks(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	for _, chunk := range chunkChanges(changes, recordSetChangeBatchMaxSize) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: aws.String("Managed by Terraform"),
+				Changes: chunk,
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return err
+		}
+
+		if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+			return fmt.Errorf("waiting for Route 53 change (%s) sync: %w", aws.StringValue(changeInfo.Id), err)
+		}
+	}
+
+	return nil
+}
+
+func chunkChanges(changes []*route53.Change, size int) [][]*route53.Change {
+	var chunks [][]*route53.Change
+
+	for size < len(changes) {
+		changes, chunks = changes[size:], append(chunks, changes[0:size:size])
+	}
+
+	if len(changes) > 0 {
+		chunks = append(chunks, changes)
+	}
+
+	return chunks
+}
+
+// flattenResourceRecordSet flattens a record set into a map with the attributes of an aws_route53_record resource.
+// Every attribute is present, so that maps can be compared in a set.
+func flattenResourceRecordSet(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	recordType := aws.StringValue(apiObject.Type)
+	tfMap := map[string]interface{}{
+		"alias":                            []interface{}{},
+		"cidr_routing_policy":              []interface{}{},
+		"failover_routing_policy":          []interface{}{},
+		"geolocation_routing_policy":       []interface{}{},
+		"health_check_id":                  aws.StringValue(apiObject.HealthCheckId),
+		"latency_routing_policy":           []interface{}{},
+		"multivalue_answer_routing_policy": aws.BoolValue(apiObject.MultiValueAnswer),
+		"name":                             strings.ToLower(strings.TrimSuffix(CleanRecordName(aws.StringValue(apiObject.Name)), ".")),
+		"records":                          flex.FlattenStringValueSet(FlattenResourceRecords(apiObject.ResourceRecords, recordType)),
+		"set_identifier":                   aws.StringValue(apiObject.SetIdentifier),
+		"ttl":                              int(aws.Int64Value(apiObject.TTL)),
+		"type":                             recordType,
+		"weighted_routing_policy":          []interface{}{},
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	if v := apiObject.CidrRoutingConfig; v != nil {
+		tfMap["cidr_routing_policy"] = []interface{}{map[string]interface{}{
+			"collection_id": aws.StringValue(v.CollectionId),
+			"location_name": aws.StringValue(v.LocationName),
+		}}
+	}
+
+	if v := apiObject.Failover; v != nil {
+		tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.GeoLocation; v != nil {
+		tfMap["geolocation_routing_policy"] = []interface{}{map[string]interface{}{
+			"continent":   aws.StringValue(v.ContinentCode),
+			"country":     aws.StringValue(v.CountryCode),
+			"subdivision": aws.StringValue(v.SubdivisionCode),
+		}}
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+			"region": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+			"weight": int(aws.Int64Value(v)),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,515 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestResourceRecordSetChanges(t *testing.T) {
+	t.Parallel()
+
+	recordSet := func(name, recordType string, values ...string) *route53.ResourceRecordSet {
+		apiObject := &route53.ResourceRecordSet{
+			Name: aws.String(name),
+			TTL:  aws.Int64(300),
+			Type: aws.String(recordType),
+		}
+		for _, v := range values {
+			apiObject.ResourceRecords = append(apiObject.ResourceRecords, &route53.ResourceRecord{Value: aws.String(v)})
+		}
+		return apiObject
+	}
+
+	// Attributes that the resource doesn't manage are ignored.
+	unchanged := recordSet("unchanged.example.com.", route53.RRTypeA, "192.0.2.1", "192.0.2.2")
+	unchanged.MultiValueAnswer = aws.Bool(false)
+	unchanged.TrafficPolicyInstanceId = aws.String("12345678-abcd-abcd-abcd-123456789012")
+
+	current := []*route53.ResourceRecordSet{
+		unchanged,
+		recordSet("changed.example.com.", route53.RRTypeA, "192.0.2.1"),
+		recordSet("removed.example.com.", route53.RRTypeA, "192.0.2.1"),
+		recordSet("retyped.example.com.", route53.RRTypeCname, "target.example.com"),
+	}
+	desired := []*route53.ResourceRecordSet{
+		recordSet("UNCHANGED.example.com", route53.RRTypeA, "192.0.2.2", "192.0.2.1"),
+		recordSet("changed.example.com", route53.RRTypeA, "192.0.2.3"),
+		recordSet("added.example.com", route53.RRTypeTxt, `"hello"`),
+		recordSet("retyped.example.com", route53.RRTypeA, "192.0.2.1"),
+	}
+
+	changes := tfroute53.ResourceRecordSetChanges(current, desired)
+
+	var got []string
+	for _, v := range changes {
+		got = append(got, fmt.Sprintf("%s %s %s", aws.StringValue(v.Action), strings.TrimSuffix(aws.StringValue(v.ResourceRecordSet.Name), "."), aws.StringValue(v.ResourceRecordSet.Type)))
+	}
+
+	want := []string{
+		"DELETE removed.example.com A",
+		"DELETE retyped.example.com CNAME",
+		"UPSERT changed.example.com A",
+		"CREATE added.example.com TXT",
+		"CREATE retyped.example.com A",
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got changes %q, expected %q", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d: got %q, expected %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestAccRoute53Records_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www",
+						"type":      "A",
+						"ttl":       "300",
+						"records.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "mail." + zoneName.String(),
+						"type":      "TXT",
+						"ttl":       "60",
+						"records.#": "1",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_updated(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www",
+						"type":      "A",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "api",
+						"type": "CNAME",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_unmanagedRecord(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsCount(ctx, resourceName, 2),
+					testAccCheckRecordsCreateUnmanaged(ctx, resourceName, "extra."+zoneName.String()),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_unmanagedRecordOnCreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecordsConfig_unmanagedOnCreate(zoneName.String()),
+				ExpectError: regexache.MustCompile(`records in the scope of the resource are not configured`),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_duplicateRecord(t *testing.T) {
+	ctx := acctest.Context(t)
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecordsConfig_duplicate(zoneName.String()),
+				ExpectError: regexache.MustCompile(`is configured more than once`),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_scope(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_scope(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name_suffix", "dev."+zoneName.String()),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "types.*", "A"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_suffix", "record", "types"},
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_widenScope(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53.ResourceRecordSet
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_scope(zoneName.String()),
+			},
+			{
+				// The record managed by aws_route53_record.other is brought into scope and isn't configured.
+				Config:      testAccRecordsConfig_scopeWidened(zoneName.String()),
+				ExpectError: regexache.MustCompile(`records in the scope of the resource are not configured`),
+			},
+			{
+				Config: testAccRecordsConfig_scope(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordExists(ctx, "aws_route53_record.other", &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_records" {
+				continue
+			}
+
+			_, err := tfroute53.FindHostedZoneRecordSetsByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Route 53 Hosted Zone %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckRecordsCount checks the number of record sets in the hosted zone, excluding the NS and SOA record sets at the zone apex.
+func testAccCheckRecordsCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		output, err := tfroute53.FindHostedZoneRecordSetsByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		zoneName := aws.StringValue(output.HostedZone.Name)
+		got := 0
+		for _, v := range output.RecordSets {
+			if recordType := aws.StringValue(v.Type); aws.StringValue(v.Name) == zoneName && (recordType == route53.RRTypeNs || recordType == route53.RRTypeSoa) {
+				continue
+			}
+			got++
+		}
+
+		if got != want {
+			return fmt.Errorf("Route 53 Hosted Zone %s has %d record sets, expected %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsCreateUnmanaged(ctx context.Context, n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: []*route53.Change{{
+					Action: aws.String(route53.ChangeActionCreate),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name:            aws.String(name),
+						ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.10")}},
+						TTL:             aws.Int64(60),
+						Type:            aws.String(route53.RRTypeA),
+					},
+				}},
+			},
+			HostedZoneId: aws.String(rs.Primary.ID),
+		}
+
+		changeInfo, err := tfroute53.ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return err
+		}
+
+		acctest.Provider.Meta().(*conns.AWSClient).Route53RecordSetCache().Invalidate(rs.Primary.ID)
+
+		return tfroute53.WaitForRecordSetToSync(ctx, conn, tfroute53.CleanChangeID(aws.StringValue(changeInfo.Id)))
+	}
+}
+
+func testAccRecordsConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1", "192.0.2.2"]
+  }
+
+  record {
+    name    = "mail.${aws_route53_zone.test.name}"
+    type    = "TXT"
+    ttl     = 60
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.3"]
+  }
+
+  record {
+    name    = "api"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["www.${aws_route53_zone.test.name}"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_scope(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "other" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_records" "test" {
+  zone_id     = aws_route53_zone.test.zone_id
+  name_suffix = "dev.${aws_route53_zone.test.name}"
+  types       = ["A"]
+
+  record {
+    name    = "app.dev"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.2"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_scopeWidened(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "other" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  types   = ["A"]
+
+  record {
+    name    = "app.dev"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.2"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_unmanagedOnCreate(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "other" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "other"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.10"]
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1"]
+  }
+
+  depends_on = [aws_route53_record.other]
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_duplicate(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1"]
+  }
+
+  record {
+    name    = "www.${aws_route53_zone.test.name}"
+    type    = "A"
+    ttl     = 60
+    records = ["192.0.2.2"]
+  }
+}
+`, zoneName)
+}
@@ -12,5 +12,7 @@ var (
 	FindBucketPolicy                  = findBucketPolicy
 	FindBucketVersioning              = findBucketVersioning
 	FindObjectByBucketAndKey          = findObjectByBucketAndKey
+	FindObjectSyncObjects             = findObjectSyncObjects
+	ObjectSyncGlobMatch               = objectSyncGlobMatch
 	SDKv1CompatibleCleanKey           = sdkv1CompatibleCleanKey
 )
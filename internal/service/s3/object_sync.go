@@ -0,0 +1,720 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_s3_object_sync", name="Object Sync")
+func resourceObjectSync() *schema.Resource {
+	globRuleSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectSyncCreate,
+		ReadWithoutTimeout:   resourceObjectSyncRead,
+		UpdateWithoutTimeout: resourceObjectSyncUpdate,
+		DeleteWithoutTimeout: resourceObjectSyncDelete,
+
+		CustomizeDiff: resourceObjectSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectCannedACL](),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     globRuleSchema,
+			},
+			"content_type": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     globRuleSchema,
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`/$`), `must end with "/"`),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"objects": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"server_side_encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"storage_class": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.StorageClass](),
+			},
+		},
+	}
+}
+
+func resourceObjectSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	id := objectSyncCreateResourceID(bucket, keyPrefix)
+
+	files, err := objectSyncLocalFilesFromResourceData(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Object Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	upload := make([]objectSyncFile, 0, len(files))
+	for _, v := range files {
+		upload = append(upload, v)
+	}
+
+	objects, err := objectSyncUpload(ctx, conn, d, upload)
+
+	// Record the objects uploaded so far, even on error, so that they are managed by the resource.
+	d.Set("objects", objects)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Object Sync (%s): %s", id, err)
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		remote, err := findObjectSyncObjects(ctx, conn, bucket, keyPrefix)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Object Sync (%s) objects: %s", id, err)
+		}
+
+		var keys []string
+		for k := range remote {
+			if _, ok := files[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+
+		if _, err := objectSyncDelete(ctx, conn, bucket, keys, d.Get("parallelism").(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Object Sync (%s) extraneous objects: %s", id, err)
+		}
+	}
+
+	return append(diags, resourceObjectSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	remote, err := findObjectSyncObjects(ctx, conn, d.Get("bucket").(string), d.Get("key_prefix").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Object Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Object Sync (%s): %s", d.Id(), err)
+	}
+
+	// Objects removed outside of Terraform are dropped so that they are uploaded again.
+	// The recorded checksums of objects that still exist are kept as S3 ETags are only
+	// equivalent to an MD5 digest for objects uploaded in a single part without SSE-KMS.
+	objects := make(map[string]string)
+	for k, v := range d.Get("objects").(map[string]interface{}) {
+		if _, ok := remote[k]; ok {
+			objects[k] = v.(string)
+		}
+	}
+
+	// Objects under the key prefix that weren't uploaded by this resource are recorded
+	// so that the plan shows them being removed.
+	if d.Get("delete_extraneous").(bool) {
+		for k, v := range remote {
+			if _, ok := objects[k]; !ok {
+				objects[k] = v
+			}
+		}
+	}
+
+	d.Set("objects", objects)
+
+	return diags
+}
+
+func resourceObjectSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	files, err := objectSyncLocalFilesFromResourceData(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Object Sync (%s): %s", d.Id(), err)
+	}
+
+	o, _ := d.GetChange("objects")
+	objects := flex.ExpandStringValueMap(o.(map[string]interface{}))
+
+	// Changes to the object settings are applied by uploading every object again.
+	uploadAll := d.HasChanges("acl", "cache_control", "content_type", "kms_key_id", "server_side_encryption", "storage_class")
+
+	var upload []objectSyncFile
+	for k, v := range files {
+		if uploadAll || objects[k] != v.md5 {
+			upload = append(upload, v)
+		}
+	}
+
+	uploaded, err := objectSyncUpload(ctx, conn, d, upload)
+
+	for k, v := range uploaded {
+		objects[k] = v
+	}
+
+	if err != nil {
+		d.Set("objects", objects)
+		return sdkdiag.AppendErrorf(diags, "updating S3 Object Sync (%s): %s", d.Id(), err)
+	}
+
+	var keys []string
+	for k := range objects {
+		if _, ok := files[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	deleted, err := objectSyncDelete(ctx, conn, d.Get("bucket").(string), keys, d.Get("parallelism").(int))
+
+	for _, k := range deleted {
+		delete(objects, k)
+	}
+
+	d.Set("objects", objects)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Object Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceObjectSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	var keys []string
+	for k := range d.Get("objects").(map[string]interface{}) {
+		keys = append(keys, k)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Object Sync: %s", d.Id())
+	if _, err := objectSyncDelete(ctx, conn, d.Get("bucket").(string), keys, d.Get("parallelism").(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Object Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceObjectSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source", "key_prefix", "include", "exclude"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("objects")
+		}
+	}
+
+	files, err := objectSyncLocalFiles(d.Get("source").(string), d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("include").(*schema.Set)), flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set)))
+
+	if err != nil {
+		return err
+	}
+
+	objects := tfmaps.ApplyToAll(files, func(v objectSyncFile) interface{} {
+		return v.md5
+	})
+
+	if !reflect.DeepEqual(d.Get("objects").(map[string]interface{}), objects) {
+		return d.SetNew("objects", objects)
+	}
+
+	return nil
+}
+
+func objectSyncCreateResourceID(bucket, keyPrefix string) string {
+	parts := []string{bucket, keyPrefix}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+// objectSyncFile is a local file to be synchronized with an S3 object.
+type objectSyncFile struct {
+	key     string // The S3 object key.
+	md5     string // The hex-encoded MD5 digest of the file's content.
+	path    string // The path of the file.
+	relPath string // The slash-separated path of the file relative to the source directory.
+}
+
+func objectSyncLocalFilesFromResourceData(d *schema.ResourceData) (map[string]objectSyncFile, error) {
+	return objectSyncLocalFiles(d.Get("source").(string), d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("include").(*schema.Set)), flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set)))
+}
+
+// objectSyncLocalFiles returns the files under the source directory that match the include and exclude
+// glob patterns, keyed by S3 object key.
+func objectSyncLocalFiles(source, keyPrefix string, include, exclude []string) (map[string]objectSyncFile, error) {
+	root, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	files := make(map[string]objectSyncFile)
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			fi, err := os.Stat(path)
+
+			if err != nil {
+				return err
+			}
+
+			if !fi.Mode().IsRegular() {
+				return nil
+			}
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if len(include) > 0 && !objectSyncGlobMatchAny(include, rel) {
+			return nil
+		}
+
+		if objectSyncGlobMatchAny(exclude, rel) {
+			return nil
+		}
+
+		digest, err := objectSyncFileMD5(path)
+
+		if err != nil {
+			return err
+		}
+
+		key := sdkv1CompatibleCleanKey(keyPrefix + rel)
+		files[key] = objectSyncFile{
+			key:     key,
+			md5:     digest,
+			path:    path,
+			relPath: rel,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source (%s): %w", root, err)
+	}
+
+	return files, nil
+}
+
+func objectSyncFileMD5(path string) (string, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	h := md5.New() //nolint:gosec // Used to compare with S3 ETags.
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// objectSyncGlobMatch returns whether the slash-separated path matches the glob pattern.
+// "*" matches any sequence of characters other than "/", "?" matches any single character other than "/"
+// and "**" matches any sequence of characters, including "/".
+func objectSyncGlobMatch(pattern, path string) bool {
+	var sb strings.Builder
+	runes := []rune(pattern)
+
+	sb.WriteString("^")
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' {
+				i++
+				if i+1 < len(runes) && runes[i+1] == '/' {
+					// "**/" matches zero or more directories.
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+
+	return regexache.MustCompile(sb.String()).MatchString(path)
+}
+
+func objectSyncGlobMatchAny(patterns []string, path string) bool {
+	for _, pattern := range patterns {
+		if objectSyncGlobMatch(pattern, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// objectSyncGlobRule maps files matching a glob pattern to a value.
+type objectSyncGlobRule struct {
+	pattern string
+	value   string
+}
+
+func expandObjectSyncGlobRules(tfList []interface{}) []objectSyncGlobRule {
+	var apiObjects []objectSyncGlobRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, objectSyncGlobRule{
+			pattern: tfMap["pattern"].(string),
+			value:   tfMap["value"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+// objectSyncGlobRuleValue returns the value of the first rule whose pattern matches the path.
+func objectSyncGlobRuleValue(rules []objectSyncGlobRule, path string) (string, bool) {
+	for _, rule := range rules {
+		if objectSyncGlobMatch(rule.pattern, path) {
+			return rule.value, true
+		}
+	}
+
+	return "", false
+}
+
+// objectSyncContentType returns the content type of the file, detected from its extension
+// or, failing that, its content.
+func objectSyncContentType(f io.ReadSeeker, path string) (string, error) {
+	if v := mime.TypeByExtension(filepath.Ext(path)); v != "" {
+		return v, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(f, buf)
+
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// objectSyncUpload uploads the files in parallel, returning the MD5 digests of the objects successfully uploaded.
+func objectSyncUpload(ctx context.Context, conn *s3.Client, d *schema.ResourceData, files []objectSyncFile) (map[string]string, error) {
+	bucket := d.Get("bucket").(string)
+	acl := d.Get("acl").(string)
+	cacheControlRules := expandObjectSyncGlobRules(d.Get("cache_control").([]interface{}))
+	contentTypeRules := expandObjectSyncGlobRules(d.Get("content_type").([]interface{}))
+	kmsKeyID := d.Get("kms_key_id").(string)
+	serverSideEncryption := d.Get("server_side_encryption").(string)
+	storageClass := d.Get("storage_class").(string)
+	uploader := manager.NewUploader(conn)
+
+	upload := func(ctx context.Context, file objectSyncFile) error {
+		f, err := os.Open(file.path)
+
+		if err != nil {
+			return fmt.Errorf("opening S3 object source (%s): %w", file.path, err)
+		}
+
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", file.path, err)
+			}
+		}()
+
+		input := &s3.PutObjectInput{
+			Body:   f,
+			Bucket: aws.String(bucket),
+			Key:    aws.String(file.key),
+		}
+
+		if acl != "" {
+			input.ACL = types.ObjectCannedACL(acl)
+		}
+
+		if v, ok := objectSyncGlobRuleValue(cacheControlRules, file.relPath); ok {
+			input.CacheControl = aws.String(v)
+		}
+
+		if v, ok := objectSyncGlobRuleValue(contentTypeRules, file.relPath); ok {
+			input.ContentType = aws.String(v)
+		} else {
+			v, err := objectSyncContentType(f, file.path)
+
+			if err != nil {
+				return fmt.Errorf("detecting content type of S3 object source (%s): %w", file.path, err)
+			}
+
+			input.ContentType = aws.String(v)
+		}
+
+		if serverSideEncryption != "" {
+			input.ServerSideEncryption = types.ServerSideEncryption(serverSideEncryption)
+		}
+
+		if kmsKeyID != "" {
+			input.SSEKMSKeyId = aws.String(kmsKeyID)
+			input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+		}
+
+		if storageClass != "" {
+			input.StorageClass = types.StorageClass(storageClass)
+		}
+
+		if _, err := uploader.Upload(ctx, input); err != nil {
+			return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", file.key, bucket, err)
+		}
+
+		return nil
+	}
+
+	var mutex sync.Mutex
+	uploaded := make(map[string]string)
+	err := objectSyncForEach(ctx, files, d.Get("parallelism").(int), func(ctx context.Context, file objectSyncFile) error {
+		if err := upload(ctx, file); err != nil {
+			return err
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		uploaded[file.key] = file.md5
+
+		return nil
+	})
+
+	return uploaded, err
+}
+
+// objectSyncDelete deletes the objects in parallel, returning the keys of the objects successfully deleted.
+func objectSyncDelete(ctx context.Context, conn *s3.Client, bucket string, keys []string, parallelism int) ([]string, error) {
+	var mutex sync.Mutex
+	var deleted []string
+	err := objectSyncForEach(ctx, keys, parallelism, func(ctx context.Context, key string) error {
+		if err := deleteObjectVersion(ctx, conn, bucket, key, "", false); err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		deleted = append(deleted, key)
+
+		return nil
+	})
+
+	sort.Strings(deleted)
+
+	return deleted, err
+}
+
+// objectSyncForEach calls fn for each item, with at most parallelism calls in flight.
+func objectSyncForEach[T any](ctx context.Context, items []T, parallelism int, fn func(context.Context, T) error) error {
+	var errs []error
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelism)
+
+	for _, item := range items {
+		item := item
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := fn(ctx, item); err != nil {
+				mutex.Lock()
+				defer mutex.Unlock()
+				errs = append(errs, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func findObjectSyncObjects(ctx context.Context, conn *s3.Client, bucket, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	output := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+			output[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return output, nil
+}
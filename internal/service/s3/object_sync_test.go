@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestObjectSyncGlobMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "*.html", path: "index.html", want: true},
+		{pattern: "*.html", path: "docs/index.html", want: false},
+		{pattern: "**/*.html", path: "index.html", want: true},
+		{pattern: "**/*.html", path: "docs/v1/index.html", want: true},
+		{pattern: "assets/**", path: "assets/css/site.css", want: true},
+		{pattern: "assets/**", path: "index.html", want: false},
+		{pattern: "file?.txt", path: "file1.txt", want: true},
+		{pattern: "file?.txt", path: "file10.txt", want: false},
+		{pattern: "a?b", path: "a/b", want: false},
+		{pattern: "(x).txt", path: "(x).txt", want: true},
+		{pattern: "(x).txt", path: "x.txt", want: false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%s %s", testCase.pattern, testCase.path), func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.ObjectSyncGlobMatch(testCase.pattern, testCase.path), testCase.want; got != want {
+				t.Errorf("ObjectSyncGlobMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.path, got, want)
+			}
+		})
+	}
+}
+
+func TestAccS3ObjectSync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectSyncCreateTempDir(t, map[string]string{
+		"index.html":      "<html></html>",
+		"css/site.css":    "body {}",
+		"data/.gitignore": "*",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "objects.site/index.html", "c83301425b2ad1d496473a5ff3d9ecca"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, "parallelism", "10"),
+					testAccCheckObjectSyncObjectHeaders(ctx, rName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckObjectSyncObjectHeaders(ctx, rName, "site/css/site.css", "text/css; charset=utf-8", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectSync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectSyncCreateTempDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccObjectSyncWriteFile(t, source, "index.html", "<html><body></body></html>")
+					testAccObjectSyncWriteFile(t, source, "js/site.js", "")
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectSyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "objects.site/index.html", "b256d97fbb697428b7a1286ea33539c0"),
+					resource.TestCheckResourceAttr(resourceName, "objects.site/js/site.js", "d41d8cd98f00b204e9800998ecf8427e"),
+					resource.TestCheckNoResourceAttr(resourceName, "objects.site/css/site.css"),
+					testAccCheckObjectSyncObjectNotExists(ctx, rName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectSync_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectSyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSyncConfig_deleteExtraneous(rName, source, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", "false"),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccObjectSyncPutObject(ctx, t, rName, "site/extraneous.txt")
+					testAccObjectSyncPutObject(ctx, t, rName, "other.txt")
+					testAccObjectSyncPutObject(ctx, t, rName, "site-old/index.html")
+				},
+				Config: testAccObjectSyncConfig_deleteExtraneous(rName, source, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", "true"),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+					testAccCheckObjectSyncObjectNotExists(ctx, rName, "site/extraneous.txt"),
+					testAccCheckObjectSyncObjectExists(ctx, rName, "other.txt"),
+					testAccCheckObjectSyncObjectExists(ctx, rName, "site-old/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectSync_keyPrefixWithoutSlash(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectSyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectSyncConfig_keyPrefix(rName, source, "site"),
+				ExpectError: regexache.MustCompile(`must end with "/"`),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectSyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_object_sync" {
+				continue
+			}
+
+			output, err := tfs3.FindObjectSyncObjects(ctx, conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for k := range output {
+				if _, ok := rs.Primary.Attributes["objects."+k]; ok {
+					return fmt.Errorf("S3 Object Sync %s object %s still exists", rs.Primary.ID, k)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectSyncObjectExists(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		return err
+	}
+}
+
+func testAccCheckObjectSyncObjectNotExists(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket (%s) Object (%s) still exists", bucket, key)
+	}
+}
+
+func testAccCheckObjectSyncObjectHeaders(ctx context.Context, bucket, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.ContentType), contentType; got != want {
+			return fmt.Errorf("S3 Bucket (%s) Object (%s) Content-Type = %v, want %v", bucket, key, got, want)
+		}
+
+		if got, want := aws.ToString(output.CacheControl), cacheControl; got != want {
+			return fmt.Errorf("S3 Bucket (%s) Object (%s) Cache-Control = %v, want %v", bucket, key, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccObjectSyncPutObject(ctx context.Context, t *testing.T, bucket, key string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+	input := &s3.PutObjectInput{
+		Body:   strings.NewReader(key),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if _, err := conn.PutObject(ctx, input); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccObjectSyncCreateTempDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, data := range files {
+		testAccObjectSyncWriteFile(t, dir, name, data)
+	}
+
+	return dir
+}
+
+func testAccObjectSyncWriteFile(t *testing.T, dir, name, data string) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccObjectSyncConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source     = %[2]q
+  key_prefix = "site/"
+
+  exclude = ["**/.gitignore"]
+
+  cache_control {
+    pattern = "**/*.html"
+    value   = "no-cache"
+  }
+}
+`, rName, source)
+}
+
+func testAccObjectSyncConfig_deleteExtraneous(rName, source string, deleteExtraneous bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source     = %[2]q
+  key_prefix = "site/"
+
+  delete_extraneous = %[3]t
+}
+`, rName, source, deleteExtraneous)
+}
+
+func testAccObjectSyncConfig_keyPrefix(rName, source, keyPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source     = %[2]q
+  key_prefix = %[3]q
+}
+`, rName, source, keyPrefix)
+}
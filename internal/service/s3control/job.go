@@ -0,0 +1,1756 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_job", name="Job")
+// @Tags
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"manifest", "manifest_generator"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.JobManifestFieldName](),
+										},
+									},
+									"format": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.JobManifestFormat](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"manifest_generator": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_job_manifest_generator": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_manifest_output": {
+										Type:     schema.TypeBool,
+										Required: true,
+										ForceNew: true,
+									},
+									"expected_bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"created_after": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"created_before": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"eligible_for_replication": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+												"object_replication_statuses": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type:             schema.TypeString,
+														ValidateDiagFunc: enum.Validate[types.ReplicationStatus](),
+													},
+												},
+											},
+										},
+									},
+									"manifest_output_location": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"expected_manifest_bucket_owner": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidAccountID,
+												},
+												"manifest_encryption": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"sse_kms_key_id": {
+																Type:          schema.TypeString,
+																Optional:      true,
+																ForceNew:      true,
+																ValidateFunc:  verify.ValidARN,
+																ConflictsWith: []string{"manifest_generator.0.s3_job_manifest_generator.0.manifest_output_location.0.manifest_encryption.0.sse_s3"},
+															},
+															"sse_s3": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"manifest_format": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.GeneratedManifestFormat](),
+												},
+												"manifest_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"source_bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"glacier_job_tier": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3GlacierJobTier](),
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3CannedAccessControlList](),
+									},
+									"checksum_algorithm": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ChecksumAlgorithm](),
+									},
+									"metadata_directive": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3MetadataDirective](),
+									},
+									"modified_since_constraint": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"new_object_metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cache_control": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_disposition": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_encoding": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_language": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"content_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"http_expires_date": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"sse_algorithm": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.S3SSEAlgorithm](),
+												},
+												"user_metadata": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"object_lock_legal_hold_status": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockLegalHoldStatus](),
+									},
+									"object_lock_mode": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockMode](),
+									},
+									"object_lock_retain_until_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"redirect_location": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"storage_class": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"unmodified_since_constraint": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+						"s3_put_object_legal_hold": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockLegalHoldStatus](),
+									},
+								},
+							},
+						},
+						"s3_put_object_retention": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bypass_governance_retention": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"mode": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectLockRetentionMode](),
+									},
+									"retain_until_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportFormat](),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportScope](),
+						},
+					},
+				},
+			},
+			"requested_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.RequestedJobStatus](),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.JobStatus](),
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// The reason is only sent to S3 with a status update.
+				if d.Id() != "" && d.HasChange("status_update_reason") && !d.HasChange("requested_status") {
+					return errors.New("status_update_reason can only be changed together with requested_status")
+				}
+
+				return nil
+			},
+		),
+	}
+}
+
+var jobOperationKeys = []string{
+	"operation.0.lambda_invoke",
+	"operation.0.s3_initiate_restore_object",
+	"operation.0.s3_put_object_copy",
+	"operation.0.s3_put_object_legal_hold",
+	"operation.0.s3_put_object_retention",
+	"operation.0.s3_put_object_tagging",
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int32(int32(d.Get("priority").(int))),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_generator"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManifestGenerator = expandJobManifestGenerator(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Operation = expandJobOperation(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateJob(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Batch Operations Job: %s", err)
+	}
+
+	d.SetId(JobCreateResourceID(accountID, aws.ToString(output.JobId)))
+
+	if v, ok := d.GetOk("requested_status"); ok {
+		// A job that requires confirmation must finish preparing before it can be confirmed.
+		if v.(string) == string(types.RequestedJobStatusReady) && d.Get("confirmation_required").(bool) {
+			if _, err := waitJobStatus(ctx, conn, accountID, aws.ToString(output.JobId), types.JobStatusSuspended, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return diag.Errorf("waiting for S3 Batch Operations Job (%s) to require confirmation: %s", d.Id(), err)
+			}
+		}
+
+		if err := updateJobStatus(ctx, conn, accountID, aws.ToString(output.JobId), types.RequestedJobStatus(v.(string)), d.Get("status_update_reason").(string)); err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("wait_for_status"); ok {
+		if _, err := waitJobStatus(ctx, conn, accountID, aws.ToString(output.JobId), types.JobStatus(v.(string)), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("waiting for S3 Batch Operations Job (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	job, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	d.Set("creation_time", aws.ToTime(job.CreationTime).Format(time.RFC3339))
+	d.Set("description", job.Description)
+	if err := d.Set("failure_reasons", flattenJobFailures(job.FailureReasons)); err != nil {
+		return diag.Errorf("setting failure_reasons: %s", err)
+	}
+	d.Set("job_id", job.JobId)
+	if job.Manifest != nil {
+		if err := d.Set("manifest", []interface{}{flattenJobManifest(job.Manifest)}); err != nil {
+			return diag.Errorf("setting manifest: %s", err)
+		}
+	} else {
+		d.Set("manifest", nil)
+	}
+	if v, ok := job.ManifestGenerator.(*types.JobManifestGeneratorMemberS3JobManifestGenerator); ok {
+		if err := d.Set("manifest_generator", []interface{}{flattenJobManifestGenerator(&v.Value)}); err != nil {
+			return diag.Errorf("setting manifest_generator: %s", err)
+		}
+	} else {
+		d.Set("manifest_generator", nil)
+	}
+	if job.Operation != nil {
+		if err := d.Set("operation", []interface{}{flattenJobOperation(ctx, job.Operation)}); err != nil {
+			return diag.Errorf("setting operation: %s", err)
+		}
+	} else {
+		d.Set("operation", nil)
+	}
+	d.Set("priority", job.Priority)
+	if job.ProgressSummary != nil {
+		if err := d.Set("progress_summary", []interface{}{flattenJobProgressSummary(job.ProgressSummary)}); err != nil {
+			return diag.Errorf("setting progress_summary: %s", err)
+		}
+	} else {
+		d.Set("progress_summary", nil)
+	}
+	if job.Report != nil {
+		if err := d.Set("report", []interface{}{flattenJobReport(job.Report)}); err != nil {
+			return diag.Errorf("setting report: %s", err)
+		}
+	} else {
+		d.Set("report", nil)
+	}
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+	d.Set("status_update_reason", job.StatusUpdateReason)
+
+	tags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return diag.Errorf("listing tags for S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	return nil
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  int32(d.Get("priority").(int)),
+		}
+
+		_, err := conn.UpdateJobPriority(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) priority: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("requested_status") {
+		if v, ok := d.GetOk("requested_status"); ok {
+			if err := updateJobStatus(ctx, conn, accountID, jobID, types.RequestedJobStatus(v.(string)), d.Get("status_update_reason").(string)); err != nil {
+				return diag.Errorf("updating S3 Batch Operations Job (%s) status: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := jobUpdateTags(ctx, conn, accountID, jobID, o, n); err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("requested_status", "wait_for_status") {
+		if v, ok := d.GetOk("wait_for_status"); ok {
+			if _, err := waitJobStatus(ctx, conn, accountID, jobID, types.JobStatus(v.(string)), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for S3 Batch Operations Job (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Jobs can't be deleted; S3 retains them for 90 days after they finish.
+	// Cancel any job that is still in progress.
+	job, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	switch job.Status {
+	case types.JobStatusCancelled, types.JobStatusCancelling, types.JobStatusComplete, types.JobStatusCompleting, types.JobStatusFailed, types.JobStatusFailing:
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	err = updateJobStatus(ctx, conn, accountID, jobID, types.RequestedJobStatusCancelled, "")
+
+	// The job may have finished since its status was read.
+	if errs.IsA[*types.NotFoundException](err) || errs.IsA[*types.JobStatusException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("cancelling S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobStatus(ctx, conn, accountID, jobID, types.JobStatusCancelled, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for S3 Batch Operations Job (%s) cancel: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const jobResourceIDSeparator = ":"
+
+func JobCreateResourceID(accountID, jobID string) string {
+	parts := []string{accountID, jobID}
+	id := strings.Join(parts, jobResourceIDSeparator)
+
+	return id
+}
+
+func JobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, jobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sjob-id", id, jobResourceIDSeparator)
+}
+
+func updateJobStatus(ctx context.Context, conn *s3control.Client, accountID, jobID string, status types.RequestedJobStatus, reason string) error {
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: status,
+	}
+
+	if reason != "" {
+		input.StatusUpdateReason = aws.String(reason)
+	}
+
+	_, err := conn.UpdateJobStatus(ctx, input)
+
+	return err
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, jobID string) (*types.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func statusJob(ctx context.Context, conn *s3control.Client, accountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// waitJobStatus waits for a job to reach the specified status.
+// A job that completes is considered to have passed through any other status.
+func waitJobStatus(ctx context.Context, conn *s3control.Client, accountID, jobID string, status types.JobStatus, timeout time.Duration) (*types.JobDescriptor, error) {
+	target := []string{string(status)}
+	if status != types.JobStatusComplete {
+		target = append(target, string(types.JobStatusComplete))
+	}
+
+	var pending []string
+	for _, v := range enum.Values[types.JobStatus]() {
+		switch types.JobStatus(v) {
+		case status, types.JobStatusComplete, types.JobStatusCancelled, types.JobStatusFailed:
+		default:
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		if len(output.FailureReasons) > 0 {
+			var errs []error
+			for _, v := range output.FailureReasons {
+				errs = append(errs, fmt.Errorf("%s: %s", aws.ToString(v.FailureCode), aws.ToString(v.FailureReason)))
+			}
+			tfresource.SetLastError(err, errors.Join(errs...))
+		} else if v := output.StatusUpdateReason; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func jobListTags(ctx context.Context, conn *s3control.Client, accountID, jobID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetJobTaggingInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.GetJobTagging(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return KeyValueTags(ctx, output.Tags), nil
+}
+
+func jobUpdateTags(ctx context.Context, conn *s3control.Client, accountID, jobID string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("listing tags: %s", err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Tags:      Tags(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("setting tags: %s", err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+		}
+
+		_, err := conn.DeleteJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting tags: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *types.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifest{}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Location = expandJobManifestLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Spec = expandJobManifestSpec(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandJobManifestLocation(tfMap map[string]interface{}) *types.JobManifestLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestLocation{}
+
+	if v, ok := tfMap["etag"].(string); ok && v != "" {
+		apiObject.ETag = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+		apiObject.ObjectArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+		apiObject.ObjectVersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestSpec(tfMap map[string]interface{}) *types.JobManifestSpec {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestSpec{}
+
+	if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Fields = flex.ExpandStringyValueList[types.JobManifestFieldName](v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = types.JobManifestFormat(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestGenerator(tfMap map[string]interface{}) types.JobManifestGenerator {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["s3_job_manifest_generator"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return &types.JobManifestGeneratorMemberS3JobManifestGenerator{
+			Value: *expandS3JobManifestGenerator(v[0].(map[string]interface{})),
+		}
+	}
+
+	return nil
+}
+
+func expandS3JobManifestGenerator(tfMap map[string]interface{}) *types.S3JobManifestGenerator {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3JobManifestGenerator{}
+
+	if v, ok := tfMap["enable_manifest_output"].(bool); ok {
+		apiObject.EnableManifestOutput = v
+	}
+
+	if v, ok := tfMap["expected_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandJobManifestGeneratorFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["manifest_output_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManifestOutputLocation = expandS3ManifestOutputLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source_bucket"].(string); ok && v != "" {
+		apiObject.SourceBucket = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestGeneratorFilter(tfMap map[string]interface{}) *types.JobManifestGeneratorFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestGeneratorFilter{}
+
+	if v, ok := tfMap["created_after"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedAfter = aws.Time(v)
+	}
+
+	if v, ok := tfMap["created_before"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedBefore = aws.Time(v)
+	}
+
+	if v, ok := tfMap["eligible_for_replication"].(bool); ok && v {
+		apiObject.EligibleForReplication = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["object_replication_statuses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ObjectReplicationStatuses = flex.ExpandStringyValueSet[types.ReplicationStatus](v)
+	}
+
+	return apiObject
+}
+
+func expandS3ManifestOutputLocation(tfMap map[string]interface{}) *types.S3ManifestOutputLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ManifestOutputLocation{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["expected_manifest_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedManifestBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManifestEncryption = expandGeneratedManifestEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["manifest_format"].(string); ok && v != "" {
+		apiObject.ManifestFormat = types.GeneratedManifestFormat(v)
+	}
+
+	if v, ok := tfMap["manifest_prefix"].(string); ok && v != "" {
+		apiObject.ManifestPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGeneratedManifestEncryption(tfMap map[string]interface{}) *types.GeneratedManifestEncryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.GeneratedManifestEncryption{}
+
+	if v, ok := tfMap["sse_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEKMS = &types.SSEKMSEncryption{
+			KeyId: aws.String(v),
+		}
+	}
+
+	if v, ok := tfMap["sse_s3"].(bool); ok && v {
+		apiObject.SSES3 = &types.SSES3Encryption{}
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(ctx context.Context, tfMap map[string]interface{}) *types.JobOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.LambdaInvoke = &types.LambdaInvokeOperation{
+			FunctionArn: aws.String(tfMap["function_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3InitiateRestoreObject = expandS3InitiateRestoreObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(ctx, v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_legal_hold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3PutObjectLegalHold = &types.S3SetObjectLegalHoldOperation{
+			LegalHold: &types.S3ObjectLockLegalHold{
+				Status: types.S3ObjectLockLegalHoldStatus(tfMap["status"].(string)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["s3_put_object_retention"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectRetention = expandS3SetObjectRetentionOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3PutObjectTagging = &types.S3SetObjectTaggingOperation{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["tag_set"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.S3PutObjectTagging.TagSet = Tags(tftags.New(ctx, v))
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3InitiateRestoreObjectOperation(tfMap map[string]interface{}) *types.S3InitiateRestoreObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3InitiateRestoreObjectOperation{}
+
+	if v, ok := tfMap["expiration_in_days"].(int); ok && v != 0 {
+		apiObject.ExpirationInDays = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+		apiObject.GlacierJobTier = types.S3GlacierJobTier(v)
+	}
+
+	return apiObject
+}
+
+func expandS3CopyObjectOperation(ctx context.Context, tfMap map[string]interface{}) *types.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok {
+		apiObject.BucketKeyEnabled = v
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = types.S3CannedAccessControlList(v)
+	}
+
+	if v, ok := tfMap["checksum_algorithm"].(string); ok && v != "" {
+		apiObject.ChecksumAlgorithm = types.S3ChecksumAlgorithm(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = types.S3MetadataDirective(v)
+	}
+
+	if v, ok := tfMap["modified_since_constraint"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ModifiedSinceConstraint = aws.Time(v)
+	}
+
+	if v, ok := tfMap["new_object_metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NewObjectMetadata = expandS3ObjectMetadata(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.NewObjectTagging = Tags(tftags.New(ctx, v))
+	}
+
+	if v, ok := tfMap["object_lock_legal_hold_status"].(string); ok && v != "" {
+		apiObject.ObjectLockLegalHoldStatus = types.S3ObjectLockLegalHoldStatus(v)
+	}
+
+	if v, ok := tfMap["object_lock_mode"].(string); ok && v != "" {
+		apiObject.ObjectLockMode = types.S3ObjectLockMode(v)
+	}
+
+	if v, ok := tfMap["object_lock_retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.ObjectLockRetainUntilDate = aws.Time(v)
+	}
+
+	if v, ok := tfMap["redirect_location"].(string); ok && v != "" {
+		apiObject.RedirectLocation = aws.String(v)
+	}
+
+	if v, ok := tfMap["requester_pays"].(bool); ok {
+		apiObject.RequesterPays = v
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = types.S3StorageClass(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	if v, ok := tfMap["unmodified_since_constraint"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.UnModifiedSinceConstraint = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func expandS3ObjectMetadata(tfMap map[string]interface{}) *types.S3ObjectMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ObjectMetadata{}
+
+	if v, ok := tfMap["cache_control"].(string); ok && v != "" {
+		apiObject.CacheControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_disposition"].(string); ok && v != "" {
+		apiObject.ContentDisposition = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_encoding"].(string); ok && v != "" {
+		apiObject.ContentEncoding = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_language"].(string); ok && v != "" {
+		apiObject.ContentLanguage = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_type"].(string); ok && v != "" {
+		apiObject.ContentType = aws.String(v)
+	}
+
+	if v, ok := tfMap["http_expires_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.HttpExpiresDate = aws.Time(v)
+	}
+
+	if v, ok := tfMap["sse_algorithm"].(string); ok && v != "" {
+		apiObject.SSEAlgorithm = types.S3SSEAlgorithm(v)
+	}
+
+	if v, ok := tfMap["user_metadata"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserMetadata = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectRetentionOperation(tfMap map[string]interface{}) *types.S3SetObjectRetentionOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3SetObjectRetentionOperation{
+		Retention: &types.S3Retention{},
+	}
+
+	if v, ok := tfMap["bypass_governance_retention"].(bool); ok && v {
+		apiObject.BypassGovernanceRetention = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Retention.Mode = types.S3ObjectLockRetentionMode(v)
+	}
+
+	if v, ok := tfMap["retain_until_date"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.Retention.RetainUntilDate = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func expandJobReport(tfMap map[string]interface{}) *types.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobReport{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = v
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = types.JobReportFormat(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = types.JobReportScope(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobFailures(apiObjects []types.JobFailure) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"failure_code":   aws.ToString(apiObject.FailureCode),
+			"failure_reason": aws.ToString(apiObject.FailureReason),
+		})
+	}
+
+	return tfList
+}
+
+func flattenJobManifest(apiObject *types.JobManifest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = []interface{}{map[string]interface{}{
+			"etag":              aws.ToString(v.ETag),
+			"object_arn":        aws.ToString(v.ObjectArn),
+			"object_version_id": aws.ToString(v.ObjectVersionId),
+		}}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{map[string]interface{}{
+			"fields": flex.FlattenStringValueList(enum.Slice(v.Fields...)),
+			"format": v.Format,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestGenerator(apiObject *types.S3JobManifestGenerator) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	s3JobManifestGenerator := map[string]interface{}{
+		"enable_manifest_output": apiObject.EnableManifestOutput,
+		"expected_bucket_owner":  aws.ToString(apiObject.ExpectedBucketOwner),
+		"source_bucket":          aws.ToString(apiObject.SourceBucket),
+	}
+
+	if v := apiObject.Filter; v != nil {
+		filter := map[string]interface{}{
+			"object_replication_statuses": flex.FlattenStringValueSet(enum.Slice(v.ObjectReplicationStatuses...)),
+		}
+
+		if v := v.CreatedAfter; v != nil {
+			filter["created_after"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := v.CreatedBefore; v != nil {
+			filter["created_before"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := v.EligibleForReplication; v != nil {
+			filter["eligible_for_replication"] = aws.ToBool(v)
+		}
+
+		s3JobManifestGenerator["filter"] = []interface{}{filter}
+	}
+
+	if v := apiObject.ManifestOutputLocation; v != nil {
+		manifestOutputLocation := map[string]interface{}{
+			"bucket":                         aws.ToString(v.Bucket),
+			"expected_manifest_bucket_owner": aws.ToString(v.ExpectedManifestBucketOwner),
+			"manifest_format":                v.ManifestFormat,
+			"manifest_prefix":                aws.ToString(v.ManifestPrefix),
+		}
+
+		if v := v.ManifestEncryption; v != nil {
+			manifestEncryption := map[string]interface{}{
+				"sse_s3": v.SSES3 != nil,
+			}
+
+			if v := v.SSEKMS; v != nil {
+				manifestEncryption["sse_kms_key_id"] = aws.ToString(v.KeyId)
+			}
+
+			manifestOutputLocation["manifest_encryption"] = []interface{}{manifestEncryption}
+		}
+
+		s3JobManifestGenerator["manifest_output_location"] = []interface{}{manifestOutputLocation}
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_job_manifest_generator": []interface{}{s3JobManifestGenerator},
+	}
+
+	return tfMap
+}
+
+func flattenJobOperation(ctx context.Context, apiObject *types.JobOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{map[string]interface{}{
+			"function_arn": aws.ToString(v.FunctionArn),
+		}}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{map[string]interface{}{
+			"expiration_in_days": aws.ToInt32(v.ExpirationInDays),
+			"glacier_job_tier":   v.GlacierJobTier,
+		}}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{flattenS3CopyObjectOperation(ctx, v)}
+	}
+
+	if v := apiObject.S3PutObjectLegalHold; v != nil && v.LegalHold != nil {
+		tfMap["s3_put_object_legal_hold"] = []interface{}{map[string]interface{}{
+			"status": v.LegalHold.Status,
+		}}
+	}
+
+	if v := apiObject.S3PutObjectRetention; v != nil {
+		retention := map[string]interface{}{
+			"bypass_governance_retention": aws.ToBool(v.BypassGovernanceRetention),
+		}
+
+		if v := v.Retention; v != nil {
+			retention["mode"] = v.Mode
+
+			if v := v.RetainUntilDate; v != nil {
+				retention["retain_until_date"] = aws.ToTime(v).Format(time.RFC3339)
+			}
+		}
+
+		tfMap["s3_put_object_retention"] = []interface{}{retention}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tfMap["s3_put_object_tagging"] = []interface{}{map[string]interface{}{
+			"tag_set": KeyValueTags(ctx, v.TagSet).Map(),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenS3CopyObjectOperation(ctx context.Context, apiObject *types.S3CopyObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_key_enabled":            apiObject.BucketKeyEnabled,
+		"canned_access_control_list":    apiObject.CannedAccessControlList,
+		"checksum_algorithm":            apiObject.ChecksumAlgorithm,
+		"metadata_directive":            apiObject.MetadataDirective,
+		"new_object_tagging":            KeyValueTags(ctx, apiObject.NewObjectTagging).Map(),
+		"object_lock_legal_hold_status": apiObject.ObjectLockLegalHoldStatus,
+		"object_lock_mode":              apiObject.ObjectLockMode,
+		"redirect_location":             aws.ToString(apiObject.RedirectLocation),
+		"requester_pays":                apiObject.RequesterPays,
+		"sse_aws_kms_key_id":            aws.ToString(apiObject.SSEAwsKmsKeyId),
+		"storage_class":                 apiObject.StorageClass,
+		"target_key_prefix":             aws.ToString(apiObject.TargetKeyPrefix),
+		"target_resource":               aws.ToString(apiObject.TargetResource),
+	}
+
+	if v := apiObject.ModifiedSinceConstraint; v != nil {
+		tfMap["modified_since_constraint"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.NewObjectMetadata; v != nil {
+		newObjectMetadata := map[string]interface{}{
+			"cache_control":       aws.ToString(v.CacheControl),
+			"content_disposition": aws.ToString(v.ContentDisposition),
+			"content_encoding":    aws.ToString(v.ContentEncoding),
+			"content_language":    aws.ToString(v.ContentLanguage),
+			"content_type":        aws.ToString(v.ContentType),
+			"sse_algorithm":       v.SSEAlgorithm,
+			"user_metadata":       v.UserMetadata,
+		}
+
+		if v := v.HttpExpiresDate; v != nil {
+			newObjectMetadata["http_expires_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfMap["new_object_metadata"] = []interface{}{newObjectMetadata}
+	}
+
+	if v := apiObject.ObjectLockRetainUntilDate; v != nil {
+		tfMap["object_lock_retain_until_date"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.UnModifiedSinceConstraint; v != nil {
+		tfMap["unmodified_since_constraint"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenJobProgressSummary(apiObject *types.JobProgressSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"number_of_tasks_failed":    aws.ToInt64(apiObject.NumberOfTasksFailed),
+		"number_of_tasks_succeeded": aws.ToInt64(apiObject.NumberOfTasksSucceeded),
+		"total_number_of_tasks":     aws.ToInt64(apiObject.TotalNumberOfTasks),
+	}
+
+	return tfMap
+}
+
+func flattenJobReport(apiObject *types.JobReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":       aws.ToString(apiObject.Bucket),
+		"enabled":      apiObject.Enabled,
+		"format":       apiObject.Format,
+		"prefix":       aws.ToString(apiObject.Prefix),
+		"report_scope": apiObject.ReportScope,
+	}
+
+	return tfMap
+}
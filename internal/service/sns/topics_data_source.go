@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource(name="Topics")
+func newDataSourceTopics(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return framework.NewPluralDataSource(framework.PluralDataSourceSpec[*sns.Topic]{
+		TypeName:     "aws_sns_topics",
+		IDsAttribute: "names",
+		List: func(ctx context.Context, meta *conns.AWSClient, _ tfsdk.Config, fn func(*sns.Topic) bool) error {
+			conn := meta.SNSConn(ctx)
+
+			return conn.ListTopicsPagesWithContext(ctx, &sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Topics {
+					if !fn(v) {
+						return false
+					}
+				}
+
+				return !lastPage
+			})
+		},
+		ARN: func(_ *conns.AWSClient, v *sns.Topic) string {
+			return aws.StringValue(v.TopicArn)
+		},
+		ID: func(v *sns.Topic) string {
+			arn, err := arn.Parse(aws.StringValue(v.TopicArn))
+
+			if err != nil {
+				return ""
+			}
+
+			return arn.Resource
+		},
+		TaggingResourceType: "sns",
+	}), nil
+}
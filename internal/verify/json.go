@@ -12,7 +12,6 @@ import (
 	"strings"
 
 	"github.com/YakDriver/regexache"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 )
@@ -34,7 +33,7 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 		return true
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := PoliciesAreEquivalent(old, new)
 	if err != nil {
 		return false
 	}
@@ -119,7 +118,7 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 		return new, nil
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := PoliciesAreEquivalent(old, new)
 
 	if err != nil {
 		return "", err
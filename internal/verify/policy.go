@@ -0,0 +1,545 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// PoliciesAreEquivalent tests for the semantic equivalence of two IAM policy
+// documents. In addition to ignoring whitespace and element ordering, it
+// treats as equal the variations that AWS considers identical and that it
+// returns in place of what was configured:
+//
+//   - single-element lists and scalars (e.g. "Action": "s3:GetObject")
+//   - action names, which are case insensitive
+//   - condition values in any order and condition key names in any case
+//   - boolean and numeric condition values and their string forms
+//   - an account ID principal and the account's root user ARN
+//   - "Principal": "*" and "Principal": {"AWS": "*"}
+//   - a single IAM role or user unique ID and a single role or user ARN, as
+//     AWS substitutes the unique ID once the referenced principal is deleted
+//   - a missing or empty statement Sid and any other Sid, as some services
+//     add a Sid to statements that have none
+//   - an empty policy and an empty JSON object
+//
+// If either of the inputs is not a valid policy document, false is returned
+// along with an error.
+func PoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	doc1, err := parsePolicyDocument(policy1)
+	if err != nil {
+		return false, fmt.Errorf("parsing policy 1: %w", err)
+	}
+
+	doc2, err := parsePolicyDocument(policy2)
+	if err != nil {
+		return false, fmt.Errorf("parsing policy 2: %w", err)
+	}
+
+	return doc1.equivalent(doc2), nil
+}
+
+type policyDocument struct {
+	version    string
+	id         string
+	statements []*policyStatement
+	other      map[string]interface{}
+}
+
+type policyStatement struct {
+	sid           string
+	effect        string
+	actions       policyValueSet
+	notActions    policyValueSet
+	resources     policyValueSet
+	notResources  policyValueSet
+	principals    policyPrincipals
+	notPrincipals policyPrincipals
+	conditions    policyConditions
+	other         map[string]interface{}
+}
+
+// policyValueSet is a set of normalized string values.
+type policyValueSet map[string]struct{}
+
+// policyPrincipals maps a principal type (e.g. "AWS", "Service") to its values.
+type policyPrincipals map[string][]string
+
+// policyConditions maps an operator and (lowercased) key to a set of values.
+type policyConditions map[string]map[string]policyValueSet
+
+const policyPrincipalTypeAWS = "AWS"
+
+// parsePolicyDocument decodes a policy document, normalizing it into a form
+// suitable for comparison.
+// Although "policy" generally equates to JSON, AWS also has pseudo-JSON
+// policies, such as assume-role policies that can be lists of JSONs. This
+// only handles a one-length list of JSON.
+func parsePolicyDocument(policy string) (*policyDocument, error) {
+	policy = strings.TrimSpace(policy)
+	if strings.HasPrefix(policy, "[") && strings.HasSuffix(policy, "]") {
+		policy = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(policy, "["), "]"))
+	}
+	if policy == "" {
+		policy = "{}"
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, err
+	}
+
+	doc := &policyDocument{
+		other: make(map[string]interface{}),
+	}
+
+	for k, v := range raw {
+		switch k {
+		case "Version":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid Version: %v", v)
+			}
+			doc.version = s
+		case "Id":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid Id: %v", v)
+			}
+			doc.id = s
+		case "Statement":
+			switch v := v.(type) {
+			case nil:
+			case map[string]interface{}:
+				statement, err := parsePolicyStatement(v)
+				if err != nil {
+					return nil, err
+				}
+				doc.statements = append(doc.statements, statement)
+			case []interface{}:
+				for i, v := range v {
+					m, ok := v.(map[string]interface{})
+					if !ok {
+						return nil, fmt.Errorf("invalid Statement[%d]: %v", i, v)
+					}
+					statement, err := parsePolicyStatement(m)
+					if err != nil {
+						return nil, fmt.Errorf("Statement[%d]: %w", i, err)
+					}
+					doc.statements = append(doc.statements, statement)
+				}
+			default:
+				return nil, fmt.Errorf("invalid Statement: %v", v)
+			}
+		default:
+			doc.other[k] = v
+		}
+	}
+
+	return doc, nil
+}
+
+func parsePolicyStatement(raw map[string]interface{}) (*policyStatement, error) {
+	statement := &policyStatement{
+		other: make(map[string]interface{}),
+	}
+
+	for k, v := range raw {
+		var err error
+
+		switch k {
+		case "Sid":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid Sid: %v", v)
+			}
+			statement.sid = s
+		case "Effect":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid Effect: %v", v)
+			}
+			statement.effect = s
+		case "Action":
+			statement.actions, err = newPolicyValueSet(v, strings.ToLower)
+		case "NotAction":
+			statement.notActions, err = newPolicyValueSet(v, strings.ToLower)
+		case "Resource":
+			statement.resources, err = newPolicyValueSet(v, nil)
+		case "NotResource":
+			statement.notResources, err = newPolicyValueSet(v, nil)
+		case "Principal":
+			statement.principals, err = newPolicyPrincipals(v)
+		case "NotPrincipal":
+			statement.notPrincipals, err = newPolicyPrincipals(v)
+		case "Condition":
+			statement.conditions, err = newPolicyConditions(v)
+		default:
+			statement.other[k] = v
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return statement, nil
+}
+
+// newPolicyValueSet converts a JSON scalar or list into a set, applying the
+// optional normalization function to each element.
+func newPolicyValueSet(v interface{}, normalize func(string) string) (policyValueSet, error) {
+	values, err := policyStringValues(v)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(policyValueSet, len(values))
+	for _, v := range values {
+		if normalize != nil {
+			v = normalize(v)
+		}
+		set[v] = struct{}{}
+	}
+
+	return set, nil
+}
+
+// policyStringValues flattens a JSON scalar or list of scalars into strings.
+func policyStringValues(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case bool:
+		return []string{strconv.FormatBool(v)}, nil
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}, nil
+	case []interface{}:
+		var values []string
+		for _, v := range v {
+			if _, ok := v.([]interface{}); ok {
+				return nil, fmt.Errorf("nested list: %v", v)
+			}
+			s, err := policyStringValues(v)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, s...)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported value: %v", v)
+	}
+}
+
+func newPolicyPrincipals(v interface{}) (policyPrincipals, error) {
+	principals := make(policyPrincipals)
+
+	switch v := v.(type) {
+	case nil:
+	case string:
+		// "Principal": "*" is equivalent to "Principal": {"AWS": "*"}.
+		principals[policyPrincipalTypeAWS] = []string{v}
+	case map[string]interface{}:
+		for k, v := range v {
+			values, err := policyStringValues(v)
+			if err != nil {
+				return nil, err
+			}
+			if len(values) > 0 {
+				principals[k] = values
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported value: %v", v)
+	}
+
+	return principals, nil
+}
+
+func newPolicyConditions(v interface{}) (policyConditions, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unsupported value: %v", v)
+	}
+
+	conditions := make(policyConditions, len(m))
+	for operator, v := range m {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: unsupported value: %v", operator, v)
+		}
+
+		keys := make(map[string]policyValueSet, len(m))
+		for key, v := range m {
+			set, err := newPolicyValueSet(v, nil)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", operator, key, err)
+			}
+			// Condition key names are case insensitive.
+			key = strings.ToLower(key)
+			if existing, ok := keys[key]; ok {
+				for v := range set {
+					existing[v] = struct{}{}
+				}
+				continue
+			}
+			keys[key] = set
+		}
+
+		if len(keys) > 0 {
+			conditions[operator] = keys
+		}
+	}
+
+	return conditions, nil
+}
+
+func (doc *policyDocument) equivalent(other *policyDocument) bool {
+	if doc.version != other.version || doc.id != other.id {
+		return false
+	}
+
+	if !reflect.DeepEqual(doc.other, other.other) {
+		return false
+	}
+
+	return statementsEquivalent(doc.statements, other.statements) && statementsEquivalent(other.statements, doc.statements)
+}
+
+// statementsEquivalent returns whether every statement in ours has an
+// equivalent statement in theirs.
+// Policies with different numbers of statements aren't equivalent, so that
+// duplicate statements don't collapse into one.
+func statementsEquivalent(ours, theirs []*policyStatement) bool {
+	if len(ours) != len(theirs) {
+		return false
+	}
+
+	for _, our := range ours {
+		found := false
+		for _, their := range theirs {
+			if our.equivalent(their) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (statement *policyStatement) equivalent(other *policyStatement) bool {
+	// A statement ID is only an identifier and some services add one.
+	if statement.sid != "" && other.sid != "" && statement.sid != other.sid {
+		return false
+	}
+
+	if !strings.EqualFold(statement.effect, other.effect) {
+		return false
+	}
+
+	if !statement.actions.equal(other.actions) || !statement.notActions.equal(other.notActions) {
+		return false
+	}
+
+	if !statement.resources.equal(other.resources) || !statement.notResources.equal(other.notResources) {
+		return false
+	}
+
+	if !statement.principals.equivalent(other.principals) || !statement.notPrincipals.equivalent(other.notPrincipals) {
+		return false
+	}
+
+	if !statement.conditions.equal(other.conditions) {
+		return false
+	}
+
+	return reflect.DeepEqual(statement.other, other.other)
+}
+
+func (ours policyValueSet) equal(theirs policyValueSet) bool {
+	if len(ours) != len(theirs) {
+		return false
+	}
+
+	for v := range ours {
+		if _, ok := theirs[v]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ours policyPrincipals) equivalent(theirs policyPrincipals) bool {
+	if len(ours) != len(theirs) {
+		return false
+	}
+
+	for k, ourValues := range ours {
+		theirValues, ok := theirs[k]
+		if !ok {
+			return false
+		}
+
+		if k == policyPrincipalTypeAWS {
+			if !principalValuesEquivalent(ourValues, theirValues) {
+				return false
+			}
+			continue
+		}
+
+		if !stringSetsEqual(ourValues, theirValues) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// principalValuesEquivalent returns whether each AWS principal in ours can be
+// paired with a different, equivalent principal in theirs.
+// Identical values are paired first so that an account ID and its root ARN
+// can't both be paired with the same value.
+func principalValuesEquivalent(ours, theirs []string) bool {
+	if len(ours) != len(theirs) {
+		return false
+	}
+
+	// A unique ID can't be resolved to the ARN it replaced, so it's only
+	// matched when it's the sole principal on both sides.
+	if len(ours) == 1 && (uniqueIDEquivalentTo(ours[0], theirs[0]) || uniqueIDEquivalentTo(theirs[0], ours[0])) {
+		return true
+	}
+
+	paired := make([]bool, len(theirs))
+	var unpaired []string
+	for _, our := range ours {
+		found := false
+		for i, their := range theirs {
+			if !paired[i] && our == their {
+				paired[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			unpaired = append(unpaired, our)
+		}
+	}
+
+	for _, our := range unpaired {
+		found := false
+		for i, their := range theirs {
+			if !paired[i] && awsPrincipalsEquivalent(our, their) {
+				paired[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+var (
+	policyAccountIDRegexp = regexache.MustCompile(`^\d{12}$`)
+	policyUniqueIDRegexp  = regexache.MustCompile(`^(AIDA|AROA)[0-9A-Z]{16,}$`)
+)
+
+// awsPrincipalsEquivalent returns whether two "AWS" principal values identify
+// the same principal.
+func awsPrincipalsEquivalent(ours, theirs string) bool {
+	if ours == theirs {
+		return true
+	}
+
+	return awsPrincipalEquivalentTo(ours, theirs) || awsPrincipalEquivalentTo(theirs, ours)
+}
+
+func awsPrincipalEquivalentTo(short, long string) bool {
+	v, err := arn.Parse(long)
+	if err != nil || v.Service != "iam" {
+		return false
+	}
+
+	// Handle AWS converting account ID principal to root IAM user ARN
+	// ACCOUNTID == arn:PARTITION:iam::ACCOUNTID:root
+	if policyAccountIDRegexp.MatchString(short) {
+		return v.Resource == "root" && v.AccountID == short
+	}
+
+	return false
+}
+
+// uniqueIDEquivalentTo handles AWS replacing the ARN of a deleted (and possibly
+// recreated) role or user with the principal's unique ID.
+func uniqueIDEquivalentTo(id, long string) bool {
+	m := policyUniqueIDRegexp.FindStringSubmatch(id)
+	if m == nil {
+		return false
+	}
+
+	v, err := arn.Parse(long)
+	if err != nil || v.Service != "iam" {
+		return false
+	}
+
+	switch m[1] {
+	case "AIDA":
+		return strings.HasPrefix(v.Resource, "user/")
+	case "AROA":
+		return strings.HasPrefix(v.Resource, "role/")
+	}
+
+	return false
+}
+
+func stringSetsEqual(ours, theirs []string) bool {
+	o, t := make(policyValueSet, len(ours)), make(policyValueSet, len(theirs))
+	for _, v := range ours {
+		o[v] = struct{}{}
+	}
+	for _, v := range theirs {
+		t[v] = struct{}{}
+	}
+
+	return o.equal(t)
+}
+
+func (ours policyConditions) equal(theirs policyConditions) bool {
+	if len(ours) != len(theirs) {
+		return false
+	}
+
+	for operator, ourKeys := range ours {
+		theirKeys, ok := theirs[operator]
+		if !ok || len(ourKeys) != len(theirKeys) {
+			return false
+		}
+
+		for key, ourValues := range ourKeys {
+			theirValues, ok := theirKeys[key]
+			if !ok || !ourValues.equal(theirValues) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+)
+
+func TestPoliciesAreEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		policy1    string
+		policy2    string
+		equivalent bool
+		err        bool
+	}{
+		{
+			name:       "empty",
+			policy1:    ``,
+			policy2:    `{}`,
+			equivalent: true,
+		},
+		{
+			name:    "invalid JSON",
+			policy1: `{"Version": "2012-10-17",`,
+			policy2: `{}`,
+			err:     true,
+		},
+		{
+			name:    "whitespace and ordering",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			policy2: `{
+  "Statement": [
+    {
+      "Resource": "*",
+      "Action": ["s3:PutObject", "s3:GetObject"],
+      "Effect": "Allow"
+    }
+  ],
+  "Version": "2012-10-17"
+}`,
+			equivalent: true,
+		},
+		{
+			name:       "single statement object and list",
+			policy1:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "single element array",
+			policy1:    `[{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}]`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "action scalar and list",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com"}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sts:AssumeRole"],"Principal":{"Service":["ec2.amazonaws.com"]}}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "action case",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["KMS:Decrypt","kms:generateDataKey*"],"Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["kms:Decrypt","kms:GenerateDataKey*"],"Resource":"*"}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "not action case",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":"IAM:*","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":["iam:*"],"Resource":"*"}]}`,
+			equivalent: true,
+		},
+		{
+			name:    "different actions",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		{
+			name:    "resource case",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::Bucket/*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+		},
+		{
+			name:       "effect case",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		{
+			name:    "different version",
+			policy1: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name:       "missing sid",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "sid added by service",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Action":"SNS:Publish","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sns:Publish","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		{
+			name:    "sid added by service to duplicate statement",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Action":"sns:Publish","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sns:Publish","Resource":"*"},{"Effect":"Allow","Action":"sns:Publish","Resource":"*"}]}`,
+		},
+		{
+			name:    "different sids",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name:       "account ID and root ARN",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":"123456789012"}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:root"}}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "account IDs and root ARNs in GovCloud",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":["123456789012","arn:aws-us-gov:iam::210987654321:root"]}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":["210987654321","arn:aws-us-gov:iam::123456789012:root"]}}]}`,
+			equivalent: true,
+		},
+		{
+			name:    "account ID and other account root ARN",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":"123456789012"}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":"arn:aws:iam::210987654321:root"}}]}`,
+		},
+		{
+			name:    "account ID and role ARN",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":"123456789012"}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:role/example"}}]}`,
+		},
+		{
+			name:       "wildcard principal",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":"*"}}]}`,
+			equivalent: true,
+		},
+		{
+			name:    "wildcard principal and service principal",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"Service":"*"}}]}`,
+		},
+		{
+			name:       "role unique ID after role recreation",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Principal":{"AWS":"AROA4XDNJ4H4XYKNHABCD"}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:role/example"}}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "user unique ID after user recreation",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:user/example"]}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Principal":{"AWS":["AIDA4XDNJ4H4XYKNHABCD"]}}]}`,
+			equivalent: true,
+		},
+		{
+			name:    "role unique ID and user ARN",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Principal":{"AWS":"AROA4XDNJ4H4XYKNHABCD"}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:user/example"}}]}`,
+		},
+		{
+			name:    "role unique ID and several role ARNs",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Principal":{"AWS":["AROA4XDNJ4H4XYKNHABCD","arn:aws:iam::123456789012:role/a"]}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:role/a","arn:aws:iam::123456789012:role/b"]}}]}`,
+		},
+		{
+			name:    "account ID and root ARN counted once",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:root"]}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:root"}}]}`,
+		},
+		{
+			name:    "additional principal",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:role/a"}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:role/a","arn:aws:iam::123456789012:role/b"]}}]}`,
+		},
+		{
+			name:       "condition value ordering",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpce":["vpce-1","vpce-2","vpce-3"]}}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpce":["vpce-3","vpce-1","vpce-2"]}}}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "condition key case",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:sns:us-west-2:123456789012:example"}}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"ArnLike":{"AWS:SourceArn":["arn:aws:sns:us-west-2:123456789012:example"]}}}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "condition boolean and number",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false},"NumericLessThan":{"s3:TlsVersion":1.2}}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"},"NumericLessThan":{"s3:TlsVersion":"1.2"}}}]}`,
+			equivalent: true,
+		},
+		{
+			name:    "condition operator differs",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-1234567890"}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringLike":{"aws:PrincipalOrgID":"o-1234567890"}}}]}`,
+		},
+		{
+			name:    "condition value case",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":"Blue"}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":"blue"}}}]}`,
+		},
+		{
+			name:    "missing condition",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-1234567890"}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+		},
+		{
+			name:    "duplicate statement",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name: "statement ordering",
+			policy1: `{"Version":"2012-10-17","Statement":[
+  {"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"},
+  {"Sid":"List","Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:aws:s3:::example"}
+]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[
+  {"Sid":"List","Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::example"]},
+  {"Sid":"Read","Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::example/*"]}
+]}`,
+			equivalent: true,
+		},
+		{
+			name:    "extra statement",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+		},
+		{
+			name:    "different id",
+			policy1: `{"Version":"2012-10-17","Id":"a","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Id":"b","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name: "KMS key policy returned by AWS",
+			policy1: `{
+  "Version": "2012-10-17",
+  "Id": "key-default-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {"AWS": "123456789012"},
+      "Action": "kms:*",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "logs.us-west-2.amazonaws.com"},
+      "Action": ["kms:Encrypt*", "kms:Decrypt*", "kms:ReEncrypt*", "kms:GenerateDataKey*", "kms:Describe*"],
+      "Resource": "*",
+      "Condition": {"ArnLike": {"kms:EncryptionContext:aws:logs:arn": "arn:aws:logs:us-west-2:123456789012:*"}}
+    }
+  ]
+}`,
+			policy2: `{
+  "Version": "2012-10-17",
+  "Id": "key-default-1",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {"Service": "logs.us-west-2.amazonaws.com"},
+      "Action": ["kms:ReEncrypt*", "kms:GenerateDataKey*", "kms:Encrypt*", "kms:Describe*", "kms:Decrypt*"],
+      "Resource": "*",
+      "Condition": {"ArnLike": {"kms:EncryptionContext:aws:logs:arn": ["arn:aws:logs:us-west-2:123456789012:*"]}}
+    },
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`,
+			equivalent: true,
+		},
+		{
+			name:    "invalid principal",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":["*"]}]}`,
+			policy2: `{}`,
+			err:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, policies := range [][2]string{{testCase.policy1, testCase.policy2}, {testCase.policy2, testCase.policy1}} {
+				got, err := PoliciesAreEquivalent(policies[0], policies[1])
+
+				if got, want := err != nil, testCase.err; got != want {
+					t.Fatalf("PoliciesAreEquivalent() err %t, want %t: %s", got, want, err)
+				}
+
+				if got != testCase.equivalent {
+					t.Errorf("PoliciesAreEquivalent(%s, %s) = %t, want %t", policies[0], policies[1], got, testCase.equivalent)
+				}
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var accountIDRegexp = regexache.MustCompile(`^(aws|aws-managed|third-party|\d{12}|cw.{10})$`)
@@ -26,7 +28,18 @@ var partitionRegexp = regexache.MustCompile(`^aws(-[a-z]+)*$`)
 var regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
 
 // validates all listed in https://gist.github.com/shortjared/4c1e3fe52bdfa47522cfe5b41e5d6f22
-var servicePrincipalRegexp = regexache.MustCompile(`^([0-9a-z-]+\.){1,4}(amazonaws|amazon)\.com$`)
+// and their equivalents in the China, ISO and sovereign partitions.
+var servicePrincipalRegexp = regexache.MustCompile(`^([0-9a-z-]+\.){1,4}(amazon\.com|` + servicePrincipalDNSSuffixesPattern() + `)$`)
+
+func servicePrincipalDNSSuffixesPattern() string {
+	suffixes := names.ServicePrincipalDNSSuffixes()
+
+	for i, v := range suffixes {
+		suffixes[i] = regexp.QuoteMeta(v)
+	}
+
+	return strings.Join(suffixes, "|")
+}
 
 func Valid4ByteASN(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
@@ -96,14 +109,21 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 			return ws, errors
 		}
 
+		// Partitions not yet known to the provider are validated by format only.
+		partition, _ := names.PartitionForID(parsedARN.Partition)
+
 		if parsedARN.Partition == "" {
 			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing partition value", k, value))
-		} else if !partitionRegexp.MatchString(parsedARN.Partition) {
+		} else if partition == nil && !partitionRegexp.MatchString(parsedARN.Partition) {
 			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid partition value (expecting to match regular expression: %s)", k, value, partitionRegexp))
 		}
 
-		if parsedARN.Region != "" && !regionRegexp.MatchString(parsedARN.Region) {
-			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid region value (expecting to match regular expression: %s)", k, value, regionRegexp))
+		if parsedARN.Region != "" {
+			if !regionRegexp.MatchString(parsedARN.Region) {
+				errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid region value (expecting to match regular expression: %s)", k, value, regionRegexp))
+			} else if partition != nil && !partition.ContainsRegion(parsedARN.Region) {
+				errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: region %s is not in partition %s", k, value, parsedARN.Region, partition.ID))
+			}
 		}
 
 		if parsedARN.AccountID != "" && !accountIDRegexp.MatchString(parsedARN.AccountID) {
@@ -201,6 +201,9 @@ func TestValidARN(t *testing.T) {
 		"arn:aws-us-gov:ec2:us-gov-west-1:123456789012:instance/i-12345678",                // lintignore:AWSAT003,AWSAT005 // GovCloud EC2 ARN
 		"arn:aws-us-gov:s3:::bucket/object",                                                // lintignore:AWSAT005          // GovCloud S3 ARN
 		"arn:aws:cloudwatch::cw0000000000:alarm:my-alarm",                                  // lintignore:AWSAT005          // Cloudwatch Alarm
+		"arn:aws-iso-e:ec2:eu-isoe-west-1:123456789012:instance/i-12345678",                // lintignore:AWSAT003,AWSAT005 // ISOE EC2 ARN
+		"arn:aws-iso-f:s3:::bucket/object",                                                 // lintignore:AWSAT005          // ISOF S3 ARN
+		"arn:aws-unknown:s3:::bucket/object",                                               // lintignore:AWSAT005          // Unknown partition S3 ARN
 	}
 	for _, v := range validNames {
 		_, errors := ValidARN(v, "arn")
@@ -213,8 +216,10 @@ func TestValidARN(t *testing.T) {
 		"arn",
 		"123456789012",
 		"arn:aws",
-		"arn:aws:logs",            //lintignore:AWSAT005
-		"arn:aws:logs:region:*:*", //lintignore:AWSAT005
+		"arn:aws:logs",                       //lintignore:AWSAT005
+		"arn:aws:logs:region:*:*",            //lintignore:AWSAT005
+		"arn:aws_unknown:s3:::bucket/object", //lintignore:AWSAT005
+		"arn:aws:ec2:us-gov-west-1:123456789012:instance/i-12345678", //lintignore:AWSAT003,AWSAT005
 	}
 	for _, v := range invalidNames {
 		_, errors := ValidARN(v, "arn")
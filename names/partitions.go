@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package names
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Partition IDs not defined in names.go.
+const (
+	ChinaPartitionID = "aws-cn"    // AWS China partition.
+	ISOPartitionID   = "aws-iso"   // AWS ISO (US) partition.
+	ISOBPartitionID  = "aws-iso-b" // AWS ISOB (US) partition.
+	ISOEPartitionID  = "aws-iso-e" // AWS ISOE (Europe) partition.
+	ISOFPartitionID  = "aws-iso-f" // AWS ISOF partition.
+)
+
+// PartitionDatum describes an AWS partition.
+type PartitionDatum struct {
+	// ID is the partition's identifier, as used in ARNs, e.g. "aws-us-gov".
+	ID string
+	// Name is the partition's human friendly name.
+	Name string
+	// DNSSuffix is the DNS suffix of service endpoints in the partition, e.g. "amazonaws.com".
+	DNSSuffix string
+
+	// regionPrefixes are the prefixes of the partition's Region names.
+	// The partition with no prefixes matches any Region not matched by another partition.
+	regionPrefixes []string
+	// servicePrincipalDNSSuffix is the DNS suffix of AWS service principals, if it differs from DNSSuffix.
+	servicePrincipalDNSSuffix string
+	// servicePrincipals maps a service to its principal where the principal doesn't follow the partition's format.
+	servicePrincipals map[string]string
+	// hostedZoneIDs maps a service package name to the Route 53 hosted zone ID for the service's alias targets.
+	hostedZoneIDs map[string]string
+	// unavailableServices is the set of service package names not available in the partition.
+	unavailableServices map[string]struct{}
+}
+
+// partitionData is keyed by partition ID.
+var partitionData = map[string]*PartitionDatum{
+	StandardPartitionID: {
+		ID:        StandardPartitionID,
+		Name:      "AWS Standard",
+		DNSSuffix: "amazonaws.com",
+		hostedZoneIDs: map[string]string{
+			CloudFront:        "Z2FDTNDATAQYW2", // See https://docs.aws.amazon.com/Route53/latest/APIReference/API_AliasTarget.html#Route53-Type-AliasTarget-HostedZoneId
+			GlobalAccelerator: "Z2BJ6XQ5FK7U4H", // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
+		},
+	},
+	ChinaPartitionID: {
+		ID:             ChinaPartitionID,
+		Name:           "AWS China",
+		DNSSuffix:      "amazonaws.com.cn",
+		regionPrefixes: []string{"cn-"},
+		// Most service principals in the China partition are in the "amazonaws.com" domain.
+		servicePrincipalDNSSuffix: "amazonaws.com",
+		servicePrincipals: map[string]string{
+			"ec2": "ec2.amazonaws.com.cn",
+		},
+		hostedZoneIDs: map[string]string{
+			CloudFront: "Z3RFFRIM2A3IF5", // See https://docs.amazonaws.cn/en_us/aws/latest/userguide/route53.html
+		},
+		unavailableServices: serviceSet(GlobalAccelerator),
+	},
+	USGovCloudPartitionID: {
+		ID:                  USGovCloudPartitionID,
+		Name:                "AWS GovCloud (US)",
+		DNSSuffix:           "amazonaws.com",
+		regionPrefixes:      []string{"us-gov-"},
+		unavailableServices: serviceSet(CloudFront, GlobalAccelerator),
+	},
+	ISOPartitionID: {
+		ID:                  ISOPartitionID,
+		Name:                "AWS ISO (US)",
+		DNSSuffix:           "c2s.ic.gov",
+		regionPrefixes:      []string{"us-iso-"},
+		unavailableServices: serviceSet(CloudFront, GlobalAccelerator),
+	},
+	ISOBPartitionID: {
+		ID:                  ISOBPartitionID,
+		Name:                "AWS ISOB (US)",
+		DNSSuffix:           "sc2s.sgov.gov",
+		regionPrefixes:      []string{"us-isob-"},
+		unavailableServices: serviceSet(CloudFront, GlobalAccelerator),
+	},
+	ISOEPartitionID: {
+		ID:                  ISOEPartitionID,
+		Name:                "AWS ISOE (Europe)",
+		DNSSuffix:           "cloud.adc-e.uk",
+		regionPrefixes:      []string{"eu-isoe-"},
+		unavailableServices: serviceSet(CloudFront, GlobalAccelerator),
+	},
+	ISOFPartitionID: {
+		ID:                  ISOFPartitionID,
+		Name:                "AWS ISOF",
+		DNSSuffix:           "csp.hci.ic.gov",
+		regionPrefixes:      []string{"us-isof-"},
+		unavailableServices: serviceSet(CloudFront, GlobalAccelerator),
+	},
+}
+
+func serviceSet(services ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(services))
+
+	for _, v := range services {
+		m[v] = struct{}{}
+	}
+
+	return m
+}
+
+// PartitionIDs returns the IDs of all known partitions, sorted.
+func PartitionIDs() []string {
+	ids := make([]string, 0, len(partitionData))
+
+	for k := range partitionData {
+		ids = append(ids, k)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+// IsPartitionID returns whether the specified value is the ID of a known partition.
+func IsPartitionID(id string) bool {
+	_, ok := partitionData[id]
+
+	return ok
+}
+
+// PartitionForID returns the partition with the specified ID.
+func PartitionForID(id string) (*PartitionDatum, error) {
+	if v, ok := partitionData[id]; ok {
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("unknown partition: %s", id)
+}
+
+// PartitionForRegion returns the partition containing the specified Region.
+// The standard partition is returned for any Region not matched by another partition.
+func PartitionForRegion(region string) *PartitionDatum {
+	var longest string
+	var partition *PartitionDatum
+
+	for _, v := range partitionData {
+		for _, prefix := range v.regionPrefixes {
+			// "us-isob-" must take precedence over "us-iso-" et al.
+			if strings.HasPrefix(region, prefix) && len(prefix) > len(longest) {
+				longest, partition = prefix, v
+			}
+		}
+	}
+
+	if partition == nil {
+		return partitionData[StandardPartitionID]
+	}
+
+	return partition
+}
+
+// ContainsRegion returns whether the partition contains the specified Region.
+func (p *PartitionDatum) ContainsRegion(region string) bool {
+	return PartitionForRegion(region).ID == p.ID
+}
+
+// ServicePrincipal returns the IAM service principal for the specified service
+// (the principal's first label, e.g. "ec2" or "logs") in the partition.
+func (p *PartitionDatum) ServicePrincipal(service string) string {
+	if v, ok := p.servicePrincipals[service]; ok {
+		return v
+	}
+
+	return fmt.Sprintf("%s.%s", service, p.ServicePrincipalDNSSuffix())
+}
+
+// ServicePrincipalDNSSuffix returns the DNS suffix of IAM service principals in the partition.
+func (p *PartitionDatum) ServicePrincipalDNSSuffix() string {
+	if p.servicePrincipalDNSSuffix != "" {
+		return p.servicePrincipalDNSSuffix
+	}
+
+	return p.DNSSuffix
+}
+
+// HostedZoneID returns the Route 53 hosted zone ID used for alias targets of the specified
+// service package (e.g. CloudFront) in the partition.
+func (p *PartitionDatum) HostedZoneID(service string) (string, bool) {
+	v, ok := p.hostedZoneIDs[service]
+
+	return v, ok
+}
+
+// IsServiceAvailable returns whether the specified service package is available in the partition.
+func (p *PartitionDatum) IsServiceAvailable(service string) bool {
+	_, ok := p.unavailableServices[service]
+
+	return !ok
+}
+
+// ServicePrincipalDNSSuffixes returns the DNS suffixes of IAM service principals in all partitions,
+// including the suffixes of any principals that don't follow their partition's format.
+func ServicePrincipalDNSSuffixes() []string {
+	m := make(map[string]struct{})
+
+	for _, v := range partitionData {
+		m[v.ServicePrincipalDNSSuffix()] = struct{}{}
+
+		for _, v := range v.servicePrincipals {
+			if _, suffix, ok := strings.Cut(v, "."); ok {
+				m[suffix] = struct{}{}
+			}
+		}
+	}
+
+	suffixes := make([]string, 0, len(m))
+	for k := range m {
+		suffixes = append(suffixes, k)
+	}
+
+	sort.Strings(suffixes)
+
+	return suffixes
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package names
+
+import (
+	"testing"
+)
+
+func TestPartitionForRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName  string
+		Input     string
+		Expected  string
+		DNSSuffix string
+	}{
+		{
+			TestName:  "empty",
+			Input:     "",
+			Expected:  StandardPartitionID,
+			DNSSuffix: "amazonaws.com",
+		},
+		{
+			TestName:  "standard",
+			Input:     "us-west-2", //lintignore:AWSAT003
+			Expected:  StandardPartitionID,
+			DNSSuffix: "amazonaws.com",
+		},
+		{
+			TestName:  "China",
+			Input:     "cn-northwest-1", //lintignore:AWSAT003
+			Expected:  ChinaPartitionID,
+			DNSSuffix: "amazonaws.com.cn",
+		},
+		{
+			TestName:  "GovCloud",
+			Input:     "us-gov-west-1", //lintignore:AWSAT003
+			Expected:  USGovCloudPartitionID,
+			DNSSuffix: "amazonaws.com",
+		},
+		{
+			TestName:  "ISO",
+			Input:     "us-iso-east-1", //lintignore:AWSAT003
+			Expected:  ISOPartitionID,
+			DNSSuffix: "c2s.ic.gov",
+		},
+		{
+			TestName:  "ISOB",
+			Input:     "us-isob-east-1", //lintignore:AWSAT003
+			Expected:  ISOBPartitionID,
+			DNSSuffix: "sc2s.sgov.gov",
+		},
+		{
+			TestName:  "ISOE",
+			Input:     "eu-isoe-west-1", //lintignore:AWSAT003
+			Expected:  ISOEPartitionID,
+			DNSSuffix: "cloud.adc-e.uk",
+		},
+		{
+			TestName:  "ISOF",
+			Input:     "us-isof-south-1", //lintignore:AWSAT003
+			Expected:  ISOFPartitionID,
+			DNSSuffix: "csp.hci.ic.gov",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := PartitionForRegion(testCase.Input)
+
+			if got.ID != testCase.Expected {
+				t.Errorf("got %s, expected %s", got.ID, testCase.Expected)
+			}
+
+			if got.DNSSuffix != testCase.DNSSuffix {
+				t.Errorf("got DNS suffix %s, expected %s", got.DNSSuffix, testCase.DNSSuffix)
+			}
+
+			if !got.ContainsRegion(testCase.Input) {
+				t.Errorf("expected partition %s to contain %s", got.ID, testCase.Input)
+			}
+		})
+	}
+}
+
+func TestPartitionForID(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range PartitionIDs() {
+		got, err := PartitionForID(id)
+
+		if err != nil {
+			t.Errorf("got error (%s), expected no error", err)
+		} else if got.ID != id {
+			t.Errorf("got %s, expected %s", got.ID, id)
+		}
+	}
+
+	if _, err := PartitionForID("aws-unknown"); err == nil {
+		t.Error("expected error for unknown partition")
+	}
+}
+
+func TestPartitionDatumServicePrincipal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName    string
+		PartitionID string
+		Service     string
+		Expected    string
+	}{
+		{
+			TestName:    "standard",
+			PartitionID: StandardPartitionID,
+			Service:     "ec2",
+			Expected:    "ec2.amazonaws.com",
+		},
+		{
+			TestName:    "China default",
+			PartitionID: ChinaPartitionID,
+			Service:     "logs",
+			Expected:    "logs.amazonaws.com",
+		},
+		{
+			TestName:    "China override",
+			PartitionID: ChinaPartitionID,
+			Service:     "ec2",
+			Expected:    "ec2.amazonaws.com.cn",
+		},
+		{
+			TestName:    "ISO",
+			PartitionID: ISOPartitionID,
+			Service:     "lambda",
+			Expected:    "lambda.c2s.ic.gov",
+		},
+		{
+			TestName:    "ISOB",
+			PartitionID: ISOBPartitionID,
+			Service:     "lambda",
+			Expected:    "lambda.sc2s.sgov.gov",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			partition, err := PartitionForID(testCase.PartitionID)
+
+			if err != nil {
+				t.Fatalf("got error (%s), expected no error", err)
+			}
+
+			if got := partition.ServicePrincipal(testCase.Service); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPartitionDatumHostedZoneID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName    string
+		PartitionID string
+		Service     string
+		Expected    string
+		OK          bool
+	}{
+		{
+			TestName:    "standard CloudFront",
+			PartitionID: StandardPartitionID,
+			Service:     CloudFront,
+			Expected:    "Z2FDTNDATAQYW2",
+			OK:          true,
+		},
+		{
+			TestName:    "China CloudFront",
+			PartitionID: ChinaPartitionID,
+			Service:     CloudFront,
+			Expected:    "Z3RFFRIM2A3IF5",
+			OK:          true,
+		},
+		{
+			TestName:    "ISO Global Accelerator",
+			PartitionID: ISOPartitionID,
+			Service:     GlobalAccelerator,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			partition, err := PartitionForID(testCase.PartitionID)
+
+			if err != nil {
+				t.Fatalf("got error (%s), expected no error", err)
+			}
+
+			got, ok := partition.HostedZoneID(testCase.Service)
+
+			if ok != testCase.OK {
+				t.Errorf("got ok %t, expected %t", ok, testCase.OK)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			if !testCase.OK && partition.IsServiceAvailable(testCase.Service) {
+				t.Errorf("expected %s to be unavailable in partition %s", testCase.Service, partition.ID)
+			}
+		})
+	}
+}